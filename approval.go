@@ -0,0 +1,460 @@
+package cage
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const kDefaultApprovalTimeout = time.Duration(1) * time.Hour
+const kApprovalPollInterval = time.Duration(5) * time.Second
+
+// 承認待ちの間、この間隔で残り時間をログに出す
+const kApprovalLogInterval = time.Duration(1) * time.Minute
+
+const ApprovalBackendTag = "tag"
+const ApprovalBackendFile = "file"
+
+// deployコンテクストの cage.json の "approval" に書く
+// カナリアタスクが健康になってスモークテストが通った後、プライマリを更新する前に止まって承認を待つ
+// canaryRouteがあれば承認待ちの間ルールを残すので、QAがカナリアにリクエストを送って確かめられる
+type ApprovalConfig struct {
+	// 例: "30m"。デフォルトは1時間。過ぎたらロールアウトを中止する
+	Timeout string `json:"timeout,omitempty"`
+	// cage approve で承認するときの印を書く場所
+	// tag: サービスのタグ、file: dirのファイル。省略すると端末のプロンプトだけ
+	Backend string `json:"backend,omitempty"`
+	// fileのときのディレクトリ。デフォルトは一時ディレクトリの cage-approvals
+	Dir string `json:"dir,omitempty"`
+}
+
+func (c *ApprovalConfig) timeout() time.Duration {
+	if d, err := time.ParseDuration(c.Timeout); err == nil && c.Timeout != "" {
+		return d
+	}
+	return kDefaultApprovalTimeout
+}
+
+func validateApproval(c *ApprovalConfig) error {
+	if c == nil {
+		return nil
+	}
+	if c.Timeout != "" {
+		if d, err := time.ParseDuration(c.Timeout); err != nil || d <= 0 {
+			return NewErrorf("timeout of approval must be a positive duration: '%s'", c.Timeout)
+		}
+	}
+	switch c.Backend {
+	case "", ApprovalBackendTag, ApprovalBackendFile:
+	default:
+		return NewErrorf("backend of approval must be '%s' or '%s' but got '%s'", ApprovalBackendTag, ApprovalBackendFile, c.Backend)
+	}
+	return nil
+}
+
+type ApprovalState string
+
+const (
+	ApprovalPending  ApprovalState = "pending"
+	ApprovalApproved ApprovalState = "approved"
+	ApprovalRejected ApprovalState = "rejected"
+	ApprovalTimedOut ApprovalState = "timed_out"
+	// 他で決まったのでプロンプトをやめた
+	ApprovalCanceled ApprovalState = "canceled"
+	// 承認を待っている間にエラーが起きた
+	ApprovalFailed ApprovalState = "failed"
+)
+
+// 承認されなかったときのエラー
+type ApprovalError struct {
+	State   ApprovalState
+	Service string
+	Timeout time.Duration
+}
+
+func (e *ApprovalError) Error() string {
+	if e.State == ApprovalTimedOut {
+		return fmt.Sprintf("roll out of '%s' wasn't approved within %s", e.Service, e.Timeout)
+	} else if e.State == ApprovalCanceled {
+		return fmt.Sprintf("approval of roll out of '%s' was canceled", e.Service)
+	}
+	return fmt.Sprintf("roll out of '%s' was rejected", e.Service)
+}
+
+type ApprovalRequest struct {
+	Cluster           string
+	Service           string
+	CanaryService     string
+	TaskDefinitionArn string
+	// カナリアへのアクセス方法と承認のしかた
+	Instructions string
+	Timeout      time.Duration
+	// 閉じられたら待つのをやめて ApprovalCanceled を返す。nilなら最後まで待つ
+	Done <-chan struct{}
+}
+
+// 承認されればnil、拒否されたかタイムアウトしたらApprovalErrorを返す
+type Approver interface {
+	WaitForApproval(req *ApprovalRequest) error
+}
+
+type ApproverFunc func(req *ApprovalRequest) error
+
+func (f ApproverFunc) WaitForApproval(req *ApprovalRequest) error {
+	return f(req)
+}
+
+// 端末で y を入力したら承認する
+// Inは1つのgoroutineで読み続けて、そのとき出しているプロンプトに渡す
+// 並行するロールアウトのプロンプトは1つずつ出すので、同じ端末なら同じTtyApproverを使う
+type TtyApprover struct {
+	In    io.Reader
+	Out   io.Writer
+	once  sync.Once
+	lines chan string
+	turn  chan struct{}
+}
+
+func (a *TtyApprover) start() {
+	a.once.Do(func() {
+		a.lines = make(chan string)
+		a.turn = make(chan struct{}, 1)
+		go func() {
+			reader := bufio.NewReader(a.In)
+			for {
+				line, err := reader.ReadString('\n')
+				if err != nil && line == "" {
+					// 入力が閉じられたら以降のプロンプトはすべて拒否
+					close(a.lines)
+					return
+				}
+				a.lines <- strings.ToLower(strings.TrimSpace(line))
+			}
+		}()
+	})
+}
+
+func (a *TtyApprover) WaitForApproval(req *ApprovalRequest) error {
+	a.start()
+	// 人の入力を待つので実時間で測る
+	timer := time.NewTimer(req.Timeout)
+	defer timer.Stop()
+	select {
+	case a.turn <- struct{}{}:
+		defer func() { <-a.turn }()
+	case <-timer.C:
+		return &ApprovalError{State: ApprovalTimedOut, Service: req.Service, Timeout: req.Timeout}
+	case <-req.Done:
+		return &ApprovalError{State: ApprovalCanceled, Service: req.Service}
+	}
+	// プロンプトを出す前に入力された行は答えにしない
+	select {
+	case <-a.lines:
+	default:
+	}
+	if req.Instructions != "" {
+		fmt.Fprintln(a.Out, req.Instructions)
+	}
+	fmt.Fprintf(a.Out, "approve roll out of '%s' with '%s'? [y/N]: ", req.Service, req.TaskDefinitionArn)
+	select {
+	case v := <-a.lines:
+		if v == "y" || v == "yes" {
+			return nil
+		}
+		return &ApprovalError{State: ApprovalRejected, Service: req.Service}
+	case <-timer.C:
+		fmt.Fprintln(a.Out)
+		return &ApprovalError{State: ApprovalTimedOut, Service: req.Service, Timeout: req.Timeout}
+	case <-req.Done:
+		fmt.Fprintln(a.Out)
+		return &ApprovalError{State: ApprovalCanceled, Service: req.Service}
+	}
+}
+
+// 承認待ちの印。どのロールアウトへの承認かをタスク定義で区別する
+type ApprovalMarker struct {
+	State             ApprovalState
+	TaskDefinitionArn string
+}
+
+// 状態もタスク定義のARNもタグの値に使える文字 [A-Za-z0-9 _.:/=+@-] だけでできているので、そのまま並べる
+func (m *ApprovalMarker) String() string {
+	return fmt.Sprintf("%s %s", m.State, m.TaskDefinitionArn)
+}
+
+func parseApprovalMarker(s string) *ApprovalMarker {
+	v := strings.SplitN(strings.TrimSpace(s), " ", 2)
+	if len(v) != 2 {
+		return nil
+	}
+	return &ApprovalMarker{State: ApprovalState(v[0]), TaskDefinitionArn: v[1]}
+}
+
+// ロールアウトと cage approve の間で承認待ちの印をやりとりする
+type ApprovalStore interface {
+	// 印がなければnil
+	Get() (*ApprovalMarker, error)
+	Put(marker *ApprovalMarker) error
+	Delete() error
+}
+
+// backendがなければnil
+func (envars *Envars) NewApprovalStore(ctx *Context) (ApprovalStore, error) {
+	c := envars.Approval
+	if c == nil || c.Backend == "" {
+		return nil, nil
+	}
+	switch c.Backend {
+	case ApprovalBackendTag:
+		return &TagApprovalStore{Ecs: ctx.Ecs, Cluster: *envars.Cluster, Service: *envars.Service}, nil
+	case ApprovalBackendFile:
+		dir := c.Dir
+		if dir == "" {
+			dir = filepath.Join(os.TempDir(), "cage-approvals")
+		}
+		return &FileApprovalStore{Path: filepath.Join(dir, *envars.Cluster, *envars.Service)}, nil
+	}
+	return nil, NewErrorf("unknown approval backend '%s'", c.Backend)
+}
+
+// サービスのタグに印を書く
+type TagApprovalStore struct {
+	Ecs     ecsiface.ECSAPI
+	Cluster string
+	Service string
+}
+
+const ApprovalTagKey = "cage:approval"
+
+func (s *TagApprovalStore) Get() (*ApprovalMarker, error) {
+	arn, err := describeServiceArn(s.Ecs, s.Cluster, s.Service)
+	if err != nil {
+		return nil, err
+	}
+	o, err := s.Ecs.ListTagsForResource(&ecs.ListTagsForResourceInput{ResourceArn: aws.String(arn)})
+	if err != nil {
+		return nil, err
+	}
+	for _, tag := range o.Tags {
+		if *tag.Key == ApprovalTagKey {
+			return parseApprovalMarker(aws.StringValue(tag.Value)), nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *TagApprovalStore) Put(marker *ApprovalMarker) error {
+	arn, err := describeServiceArn(s.Ecs, s.Cluster, s.Service)
+	if err != nil {
+		return err
+	}
+	_, err = s.Ecs.TagResource(&ecs.TagResourceInput{
+		ResourceArn: aws.String(arn),
+		Tags:        []*ecs.Tag{{Key: aws.String(ApprovalTagKey), Value: aws.String(marker.String())}},
+	})
+	return err
+}
+
+func (s *TagApprovalStore) Delete() error {
+	arn, err := describeServiceArn(s.Ecs, s.Cluster, s.Service)
+	if err != nil {
+		return err
+	}
+	_, err = s.Ecs.UntagResource(&ecs.UntagResourceInput{
+		ResourceArn: aws.String(arn),
+		TagKeys:     []*string{aws.String(ApprovalTagKey)},
+	})
+	return err
+}
+
+// ローカルのファイルに印を書く。cage approve を同じマシンで実行するときに使う
+type FileApprovalStore struct {
+	Path string
+}
+
+func (s *FileApprovalStore) Get() (*ApprovalMarker, error) {
+	d, err := ioutil.ReadFile(s.Path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return parseApprovalMarker(string(d)), nil
+}
+
+// 書きかけのファイルを読まないように一時ファイルから置き換える
+func (s *FileApprovalStore) Put(marker *ApprovalMarker) error {
+	if err := os.MkdirAll(filepath.Dir(s.Path), 0755); err != nil {
+		return err
+	}
+	tmp := fmt.Sprintf("%s.%d.tmp", s.Path, os.Getpid())
+	if err := ioutil.WriteFile(tmp, []byte(marker.String()+"\n"), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.Path)
+}
+
+func (s *FileApprovalStore) Delete() error {
+	if err := os.Remove(s.Path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// 承認を待っているロールアウトを承認または拒否する。cage approve で使う
+func (envars *Envars) Approve(ctx *Context, approved bool) (*ApprovalMarker, error) {
+	store, err := envars.NewApprovalStore(ctx)
+	if err != nil {
+		return nil, err
+	} else if store == nil {
+		return nil, NewErrorf("backend of approval is required to approve roll out")
+	}
+	current, err := store.Get()
+	if err != nil {
+		return nil, err
+	} else if current == nil || current.State != ApprovalPending {
+		return nil, NewErrorf("no roll out of '%s' is waiting for approval", *envars.Service)
+	}
+	ret := &ApprovalMarker{State: ApprovalApproved, TaskDefinitionArn: current.TaskDefinitionArn}
+	if !approved {
+		ret.State = ApprovalRejected
+	}
+	if err := store.Put(ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (envars *Envars) approveCommandLine() string {
+	args := []string{"cage", "approve", "--region", aws.StringValue(envars.Region), "--cluster", *envars.Cluster}
+	if c := envars.Approval; c.Backend != ApprovalBackendTag {
+		args = append(args, "--backend", c.Backend)
+		if c.Dir != "" {
+			args = append(args, "--dir", c.Dir)
+		}
+	}
+	return strings.Join(append(args, *envars.Service), " ")
+}
+
+// プライマリを更新する前に止まって承認を待つ
+// 端末のプロンプトとbackendの印のどちらでも承認でき、先に決まった方に従う
+func (envars *Envars) WaitForApproval(ctx *Context, route *CanaryRoute, ret *RollOutResult) error {
+	store, err := envars.NewApprovalStore(ctx)
+	if err != nil {
+		return err
+	}
+	if store == nil && ctx.Approver == nil {
+		return NewErrorf("approval is required but no approver is available. run cage in a terminal or set backend of approval")
+	}
+	req := &ApprovalRequest{
+		Cluster:           *envars.Cluster,
+		Service:           *envars.Service,
+		CanaryService:     *envars.CanaryService,
+		TaskDefinitionArn: aws.StringValue(ret.TaskDefinitionArn),
+		Timeout:           envars.Approval.timeout(),
+	}
+	var instructions []string
+	if route != nil {
+		instructions = append(instructions, route.Description())
+	}
+	if store != nil {
+		command := envars.approveCommandLine()
+		instructions = append(instructions, fmt.Sprintf("run '%s' to continue or add '--reject' to abort", command))
+	}
+	req.Instructions = strings.Join(instructions, "\n")
+	logger := envars.logger(ctx).WithField("timeout", req.Timeout.String())
+	for _, v := range instructions {
+		logger.Info(v)
+	}
+	logger.Info("⏸ roll out paused. waiting for approval to update service...")
+	clock := ctx.clock()
+	start := clock.Now()
+	ret.Approval = ApprovalPending
+	span := ctx.Tracer.Start("approval")
+	if store != nil {
+		err = envars.waitForApprovalMarker(ctx, store, req)
+	} else {
+		err = ctx.Approver.WaitForApproval(req)
+	}
+	span.SetError(err)
+	span.End()
+	ret.ApprovalWait = clock.Now().Sub(start)
+	if err != nil {
+		ret.Approval = ApprovalFailed
+		if aerr, ok := err.(*ApprovalError); ok {
+			ret.Approval = aerr.State
+		}
+		logger.WithError(err).WithField("approval", ret.Approval).Error("roll out was not approved")
+		return err
+	}
+	ret.Approval = ApprovalApproved
+	logger.Info("▶️ roll out approved")
+	return nil
+}
+
+func (envars *Envars) waitForApprovalMarker(ctx *Context, store ApprovalStore, req *ApprovalRequest) error {
+	logger := envars.logger(ctx)
+	pending := &ApprovalMarker{State: ApprovalPending, TaskDefinitionArn: req.TaskDefinitionArn}
+	if err := store.Put(pending); err != nil {
+		return err
+	}
+	defer func() {
+		if err := store.Delete(); err != nil {
+			logger.WithError(err).Warn("failed to delete approval marker")
+		}
+	}()
+	if ctx.Approver != nil {
+		// 印で決まったら端末のプロンプトもやめる
+		done := make(chan struct{})
+		defer close(done)
+		prompt := *req
+		prompt.Done = done
+		// プロンプトの答えも印に書く。他で決まっていたら上書きしない
+		go func() {
+			state := ApprovalApproved
+			if err := ctx.Approver.WaitForApproval(&prompt); err != nil {
+				if aerr, ok := err.(*ApprovalError); ok && (aerr.State == ApprovalTimedOut || aerr.State == ApprovalCanceled) {
+					return
+				}
+				state = ApprovalRejected
+			}
+			if current, err := store.Get(); err == nil && current != nil && *current == *pending {
+				_ = store.Put(&ApprovalMarker{State: state, TaskDefinitionArn: req.TaskDefinitionArn})
+			}
+		}()
+	}
+	clock := ctx.clock()
+	deadline := clock.Now().Add(req.Timeout)
+	lastLog := clock.Now()
+	for {
+		<-clock.NewTimer(kApprovalPollInterval).C
+		// 読めなかったら次のポーリングで読み直す
+		if current, err := store.Get(); err != nil {
+			logger.WithError(err).Warn("failed to read approval marker")
+		} else if current != nil && current.TaskDefinitionArn == req.TaskDefinitionArn {
+			switch current.State {
+			case ApprovalApproved:
+				return nil
+			case ApprovalRejected:
+				return &ApprovalError{State: ApprovalRejected, Service: req.Service}
+			}
+		}
+		now := clock.Now()
+		if !now.Before(deadline) {
+			return &ApprovalError{State: ApprovalTimedOut, Service: req.Service, Timeout: req.Timeout}
+		}
+		if now.Sub(lastLog) >= kApprovalLogInterval {
+			logger.WithField("remaining", deadline.Sub(now).Truncate(time.Second).String()).Info("⏸ still waiting for approval...")
+			lastLog = now
+		}
+	}
+}
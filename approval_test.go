@@ -0,0 +1,267 @@
+package cage
+
+import (
+	"bytes"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/loilo-inc/canarycage/test"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidateApproval(t *testing.T) {
+	assert.Nil(t, validateApproval(nil))
+	assert.Nil(t, validateApproval(&ApprovalConfig{}))
+	assert.Nil(t, validateApproval(&ApprovalConfig{Timeout: "30m"}))
+	assert.NotNil(t, validateApproval(&ApprovalConfig{Timeout: "0s"}))
+	assert.NotNil(t, validateApproval(&ApprovalConfig{Timeout: "soon"}))
+	assert.Nil(t, validateApproval(&ApprovalConfig{Backend: ApprovalBackendTag}))
+	assert.NotNil(t, validateApproval(&ApprovalConfig{Backend: "slack"}))
+	assert.Equal(t, kDefaultApprovalTimeout, (&ApprovalConfig{}).timeout())
+	assert.Nil(t, validateCanaryRoute(&CanaryRouteConfig{ListenerArn: "listener", Cookie: "canary=1"}))
+	assert.NotNil(t, validateCanaryRoute(&CanaryRouteConfig{ListenerArn: "listener", Cookie: "canary"}))
+	assert.NotNil(t, validateCanaryRoute(&CanaryRouteConfig{ListenerArn: "listener", Cookie: "canary=1", HeaderName: "X-Canary"}))
+}
+
+func TestTtyApprover(t *testing.T) {
+	req := &ApprovalRequest{Service: "service", TaskDefinitionArn: "td", Instructions: "hello", Timeout: time.Second}
+	out := &bytes.Buffer{}
+	assert.Nil(t, (&TtyApprover{In: strings.NewReader("y\n"), Out: out}).WaitForApproval(req))
+	assert.Equal(t, "hello\napprove roll out of 'service' with 'td'? [y/N]: ", out.String())
+	assert.Nil(t, (&TtyApprover{In: strings.NewReader("Yes\n"), Out: out}).WaitForApproval(req))
+	assert.NotNil(t, (&TtyApprover{In: strings.NewReader("n\n"), Out: out}).WaitForApproval(req))
+	// 入力がなければ拒否
+	assert.NotNil(t, (&TtyApprover{In: strings.NewReader(""), Out: out}).WaitForApproval(req))
+	r, w := io.Pipe()
+	defer w.Close()
+	req.Timeout = time.Millisecond * 10
+	err := (&TtyApprover{In: r, Out: out}).WaitForApproval(req)
+	if assert.NotNil(t, err) {
+		assert.Equal(t, ApprovalTimedOut, err.(*ApprovalError).State)
+		assert.Equal(t, "roll out of 'service' wasn't approved within 10ms", err.Error())
+	}
+}
+
+// プロンプトが出たら answers から1つ答える
+type answeringWriter struct {
+	w       io.Writer
+	answers []string
+}
+
+func (a *answeringWriter) Write(p []byte) (int, error) {
+	if strings.HasSuffix(string(p), "[y/N]: ") && len(a.answers) > 0 {
+		answer := a.answers[0]
+		a.answers = a.answers[1:]
+		if answer != "" {
+			go func() {
+				_, _ = a.w.Write([]byte(answer))
+			}()
+		}
+	}
+	return len(p), nil
+}
+
+func TestTtyApprover_SharedInput(t *testing.T) {
+	r, w := io.Pipe()
+	defer w.Close()
+	a := &TtyApprover{In: r, Out: &answeringWriter{w: w, answers: []string{"", "y\n"}}}
+	req := &ApprovalRequest{Service: "service", TaskDefinitionArn: "td", Timeout: time.Millisecond * 10}
+	err := a.WaitForApproval(req)
+	if assert.NotNil(t, err) {
+		assert.Equal(t, ApprovalTimedOut, err.(*ApprovalError).State)
+	}
+	// タイムアウトしたプロンプトは次のプロンプトへの入力を読まない
+	req.Timeout = time.Second
+	assert.Nil(t, a.WaitForApproval(req))
+	// 他で決まったらプロンプトをやめる
+	done := make(chan struct{})
+	close(done)
+	req.Done = done
+	err = a.WaitForApproval(req)
+	if assert.NotNil(t, err) {
+		assert.Equal(t, ApprovalCanceled, err.(*ApprovalError).State)
+	}
+}
+
+func TestFileApprovalStore(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "cage-approval-test")
+	defer os.RemoveAll(dir)
+	envars := DefaultEnvars()
+	envars.Approval = &ApprovalConfig{Backend: ApprovalBackendFile, Dir: dir}
+	store, err := envars.NewApprovalStore(&Context{})
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	assert.Equal(t, filepath.Join(dir, "cage-test", "service"), store.(*FileApprovalStore).Path)
+	_, err = envars.Approve(&Context{}, true)
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "no roll out of 'service' is waiting for approval", err.Error())
+	}
+	pending := &ApprovalMarker{State: ApprovalPending, TaskDefinitionArn: "arn:aws:ecs:us-west-2:1:task-definition/app:1"}
+	assert.Nil(t, store.Put(pending))
+	marker, err := envars.Approve(&Context{}, false)
+	if assert.Nil(t, err) {
+		assert.Equal(t, ApprovalRejected, marker.State)
+	}
+	current, _ := store.Get()
+	assert.Equal(t, &ApprovalMarker{State: ApprovalRejected, TaskDefinitionArn: pending.TaskDefinitionArn}, current)
+	assert.Nil(t, store.Delete())
+	assert.Nil(t, store.Delete())
+	current, _ = store.Get()
+	assert.Nil(t, current)
+}
+
+func setupApproval(t *testing.T, approver ApproverFunc) (*Envars, *test.MockContext, *Context) {
+	envars := DefaultEnvars()
+	envars.CanaryRoute = &CanaryRouteConfig{ListenerArn: "arn://listener", Cookie: "canary=1"}
+	envars.Approval = &ApprovalConfig{Timeout: "10m"}
+	ctrl := gomock.NewController(t)
+	mocker, ctx := envars.Setup(ctrl, 2, "FARGATE")
+	ctx.Approver = approver
+	return envars, mocker, ctx
+}
+
+func TestEnvars_RollOut_Approval(t *testing.T) {
+	var envars *Envars
+	var mocker *test.MockContext
+	var ctx *Context
+	var requested *ApprovalRequest
+	var phases []RollOutPhase
+	envars, mocker, ctx = setupApproval(t, func(req *ApprovalRequest) error {
+		requested = req
+		// 止まっていることがフックに見えている
+		assert.Equal(t, PhaseAwaitingApproval, phases[len(phases)-1])
+		// 承認待ちの間だけルールと一時的なターゲットグループがある
+		assert.Equal(t, 1, len(mocker.TargetGroups))
+		for _, r := range mocker.Rules {
+			assert.Equal(t, "Cookie", *r.Conditions[0].HttpHeaderConfig.HttpHeaderName)
+			assert.Equal(t, "*canary=1*", *r.Conditions[0].HttpHeaderConfig.Values[0])
+			assert.NotNil(t, mocker.TargetGroups[*r.Actions[0].TargetGroupArn])
+		}
+		assert.Equal(t, 1, len(mocker.Rules))
+		// まだプライマリは更新しない
+		service, _ := mocker.GetService(*envars.Service)
+		assert.NotEqual(t, req.TaskDefinitionArn, *service.TaskDefinition)
+		return nil
+	})
+	ctx.Hooks = &Hooks{OnPhase: func(phase RollOutPhase) {
+		phases = append(phases, phase)
+	}}
+	result := envars.RollOut(ctx)
+	if result.Error != nil {
+		t.Fatalf(result.Error.Error())
+	}
+	assert.Equal(t, []RollOutPhase{
+		PhaseStarted, PhaseCanaryHealthy, PhaseAwaitingApproval, PhasePrimaryUpdating,
+	}, phases)
+	assert.Contains(t, result.PhaseTimes, PhaseAwaitingApproval)
+	if assert.NotNil(t, requested) {
+		assert.Equal(t, *result.TaskDefinitionArn, requested.TaskDefinitionArn)
+		assert.Equal(t, 10*time.Minute, requested.Timeout)
+		assert.True(t, strings.Contains(requested.Instructions, "cookie 'canary=1'"))
+	}
+	assert.Equal(t, 0, len(mocker.Rules))
+	assert.Equal(t, 0, len(mocker.TargetGroups))
+	service, _ := mocker.GetService(*envars.Service)
+	assert.Equal(t, *result.TaskDefinitionArn, *service.TaskDefinition)
+	assert.Equal(t, ApprovalApproved, result.Approval)
+}
+
+func TestEnvars_RollOut_ApprovalRejected(t *testing.T) {
+	envars, mocker, ctx := setupApproval(t, func(req *ApprovalRequest) error {
+		return NewErrorf("roll out was rejected")
+	})
+	result := envars.RollOut(ctx)
+	if assert.NotNil(t, result.Error) {
+		assert.Equal(t, "roll out was rejected", result.Error.Error())
+	}
+	assert.Equal(t, ApprovalFailed, result.Approval)
+	assert.True(t, result.ServiceIntact)
+	// ルールとターゲットグループとカナリアサービスを片付ける
+	assert.Equal(t, 0, len(mocker.Rules))
+	assert.Equal(t, 0, len(mocker.TargetGroups))
+	_, ok := mocker.GetService(*envars.CanaryService)
+	assert.False(t, ok)
+	service, _ := mocker.GetService(*envars.Service)
+	assert.Equal(t, *result.PreviousTaskDefinitionArn, *service.TaskDefinition)
+}
+
+func TestEnvars_RollOut_ApprovalWithoutApprover(t *testing.T) {
+	envars, _, ctx := setupApproval(t, nil)
+	ctx.Approver = nil
+	envars.CanaryRoute = nil
+	result := envars.RollOut(ctx)
+	if assert.NotNil(t, result.Error) {
+		assert.True(t, strings.Contains(result.Error.Error(), "no approver"))
+	}
+	assert.True(t, result.ServiceIntact)
+}
+
+func approvalTag(mocker *test.MockContext, service string) *ecs.Tag {
+	s, _ := mocker.GetService(service)
+	for _, v := range s.Tags {
+		if *v.Key == ApprovalTagKey {
+			return v
+		}
+	}
+	return nil
+}
+
+func TestEnvars_RollOut_ApprovalWithTag(t *testing.T) {
+	envars, mocker, ctx := setupApproval(t, nil)
+	ctx.Approver = nil
+	envars.Approval.Backend = ApprovalBackendTag
+	// 別のプロセスから cage approve を実行する
+	go func() {
+		for {
+			time.Sleep(time.Millisecond)
+			if _, err := envars.Approve(ctx, true); err == nil {
+				return
+			}
+		}
+	}()
+	result := envars.RollOut(ctx)
+	if result.Error != nil {
+		t.Fatalf(result.Error.Error())
+	}
+	assert.Equal(t, ApprovalApproved, result.Approval)
+	assert.Nil(t, approvalTag(mocker, *envars.Service))
+	assert.True(t, strings.Contains(RollOutSummary(envars, result), "Paused for approval for"))
+}
+
+func TestEnvars_RollOut_ApprovalTimedOut(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "cage-approval-test")
+	defer os.RemoveAll(dir)
+	envars, mocker, ctx := setupApproval(t, nil)
+	ctx.Approver = nil
+	envars.Approval = &ApprovalConfig{Backend: ApprovalBackendFile, Dir: dir, Timeout: "10ms"}
+	result := envars.RollOut(ctx)
+	if assert.NotNil(t, result.Error) {
+		assert.Equal(t, "roll out of 'service' wasn't approved within 10ms", result.Error.Error())
+	}
+	assert.Equal(t, ApprovalTimedOut, result.Approval)
+	assert.Equal(t, ExitCodeServiceIntact, result.ExitCode())
+	assert.Equal(t, 0, len(mocker.Rules))
+	_, err := os.Stat(filepath.Join(dir, "cage-test", "service"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestEnvars_RollOut_ApprovalRejectedByPrompt(t *testing.T) {
+	envars, mocker, ctx := setupApproval(t, func(req *ApprovalRequest) error {
+		assert.True(t, strings.Contains(req.Instructions, "cage approve --region us-west-2 --cluster cage-test service"))
+		return &ApprovalError{State: ApprovalRejected, Service: req.Service}
+	})
+	envars.Approval.Backend = ApprovalBackendTag
+	result := envars.RollOut(ctx)
+	if assert.NotNil(t, result.Error) {
+		assert.Equal(t, "roll out of 'service' was rejected", result.Error.Error())
+	}
+	assert.Equal(t, ApprovalRejected, result.Approval)
+	assert.Nil(t, approvalTag(mocker, *envars.Service))
+}
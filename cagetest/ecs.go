@@ -0,0 +1,564 @@
+package cagetest
+
+import (
+	"fmt"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+)
+
+// SimulatorのECS
+type Ecs struct {
+	ecsiface.ECSAPI
+	sim *Simulator
+}
+
+func (e *Ecs) RegisterTaskDefinition(input *ecs.RegisterTaskDefinitionInput) (*ecs.RegisterTaskDefinitionOutput, error) {
+	s := e.sim
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if err := s.begin("RegisterTaskDefinition"); err != nil {
+		return nil, err
+	} else if isEmpty(input.Family) {
+		return nil, awserr.New(ecs.ErrCodeClientException, "Family is required", nil)
+	}
+	s.revisions[*input.Family]++
+	revision := s.revisions[*input.Family]
+	td := &ecs.TaskDefinition{
+		TaskDefinitionArn:       aws.String(s.taskDefinitionArn(fmt.Sprintf("%s:%d", *input.Family, revision))),
+		Family:                  input.Family,
+		Revision:                aws.Int64(revision),
+		Status:                  aws.String("ACTIVE"),
+		ContainerDefinitions:    input.ContainerDefinitions,
+		NetworkMode:             input.NetworkMode,
+		Cpu:                     input.Cpu,
+		Memory:                  input.Memory,
+		RequiresCompatibilities: input.RequiresCompatibilities,
+		TaskRoleArn:             input.TaskRoleArn,
+		ExecutionRoleArn:        input.ExecutionRoleArn,
+		Volumes:                 input.Volumes,
+		PlacementConstraints:    input.PlacementConstraints,
+	}
+	s.taskDefinitions[*td.TaskDefinitionArn] = td
+	return &ecs.RegisterTaskDefinitionOutput{
+		TaskDefinition: copyTaskDefinition(td),
+	}, nil
+}
+
+func (e *Ecs) DescribeTaskDefinition(input *ecs.DescribeTaskDefinitionInput) (*ecs.DescribeTaskDefinitionOutput, error) {
+	s := e.sim
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if err := s.begin("DescribeTaskDefinition"); err != nil {
+		return nil, err
+	}
+	td := s.findTaskDefinition(aws.StringValue(input.TaskDefinition))
+	if td == nil {
+		return nil, awserr.New(ecs.ErrCodeClientException, "Unable to describe task definition.", nil)
+	}
+	return &ecs.DescribeTaskDefinitionOutput{
+		TaskDefinition: copyTaskDefinition(td),
+	}, nil
+}
+
+func (e *Ecs) CreateService(input *ecs.CreateServiceInput) (*ecs.CreateServiceOutput, error) {
+	s := e.sim
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if err := s.begin("CreateService"); err != nil {
+		return nil, err
+	}
+	cluster := clusterName(input.Cluster)
+	if isEmpty(input.ServiceName) {
+		return nil, awserr.New(ecs.ErrCodeInvalidParameterException, "ServiceName is required", nil)
+	} else if svc := s.findService(cluster, *input.ServiceName); svc != nil && *svc.service.Status != "INACTIVE" {
+		return nil, awserr.New(ecs.ErrCodeInvalidParameterException, "Creation of service was not idempotent.", nil)
+	}
+	td := s.findTaskDefinition(aws.StringValue(input.TaskDefinition))
+	if td == nil {
+		return nil, awserr.New(ecs.ErrCodeInvalidParameterException, "TaskDefinition not found.", nil)
+	}
+	for _, lb := range input.LoadBalancers {
+		if _, ok := s.targetGroups[aws.StringValue(lb.TargetGroupArn)]; !ok {
+			return nil, awserr.New(ecs.ErrCodeInvalidParameterException, fmt.Sprintf("Unable to assume role and validate the specified targetGroupArn: %s", aws.StringValue(lb.TargetGroupArn)), nil)
+		}
+	}
+	if input.LaunchType != nil && len(input.CapacityProviderStrategy) > 0 {
+		return nil, awserr.New(ecs.ErrCodeInvalidParameterException, "Specifying both a launch type and capacity provider strategy is not supported.", nil)
+	}
+	now := s.Clock.Now()
+	desiredCount := aws.Int64Value(input.DesiredCount)
+	s.seq++
+	deployment := &ecs.Deployment{
+		Id:                       aws.String(fmt.Sprintf("ecs-svc/%019d", s.seq)),
+		Status:                   aws.String("PRIMARY"),
+		TaskDefinition:           td.TaskDefinitionArn,
+		DesiredCount:             aws.Int64(desiredCount),
+		RunningCount:             aws.Int64(0),
+		PendingCount:             aws.Int64(0),
+		LaunchType:               input.LaunchType,
+		CapacityProviderStrategy: input.CapacityProviderStrategy,
+		NetworkConfiguration:     input.NetworkConfiguration,
+		PlatformVersion:          input.PlatformVersion,
+		CreatedAt:                aws.Time(now),
+		UpdatedAt:                aws.Time(now),
+	}
+	controller := input.DeploymentController
+	if controller == nil {
+		controller = &ecs.DeploymentController{Type: aws.String(ecs.DeploymentControllerTypeEcs)}
+	}
+	schedulingStrategy := input.SchedulingStrategy
+	if schedulingStrategy == nil {
+		schedulingStrategy = aws.String(ecs.SchedulingStrategyReplica)
+	}
+	svc := &simService{
+		cluster: cluster,
+		service: &ecs.Service{
+			ServiceArn:                    aws.String(fmt.Sprintf("arn:aws:ecs:%s:%s:service/%s/%s", s.Region, s.AccountId, cluster, *input.ServiceName)),
+			ServiceName:                   input.ServiceName,
+			ClusterArn:                    aws.String(s.clusterArn(cluster)),
+			Status:                        aws.String("ACTIVE"),
+			DesiredCount:                  aws.Int64(desiredCount),
+			RunningCount:                  aws.Int64(0),
+			PendingCount:                  aws.Int64(0),
+			TaskDefinition:                td.TaskDefinitionArn,
+			LaunchType:                    input.LaunchType,
+			CapacityProviderStrategy:      input.CapacityProviderStrategy,
+			LoadBalancers:                 input.LoadBalancers,
+			ServiceRegistries:             input.ServiceRegistries,
+			NetworkConfiguration:          input.NetworkConfiguration,
+			DeploymentConfiguration:       input.DeploymentConfiguration,
+			DeploymentController:          controller,
+			HealthCheckGracePeriodSeconds: input.HealthCheckGracePeriodSeconds,
+			PlacementConstraints:          input.PlacementConstraints,
+			PlacementStrategy:             input.PlacementStrategy,
+			PlatformVersion:               input.PlatformVersion,
+			SchedulingStrategy:            schedulingStrategy,
+			Tags:                          input.Tags,
+			CreatedAt:                     aws.Time(now),
+			Deployments:                   []*ecs.Deployment{deployment},
+		},
+	}
+	s.services = append(s.services, svc)
+	return &ecs.CreateServiceOutput{
+		Service: copyService(svc.service),
+	}, nil
+}
+
+func (s *Simulator) activeService(cluster *string, name *string) (*simService, error) {
+	svc := s.findService(clusterName(cluster), aws.StringValue(name))
+	if svc == nil {
+		return nil, awserr.New(ecs.ErrCodeServiceNotFoundException, "Service not found.", nil)
+	} else if *svc.service.Status != "ACTIVE" {
+		return nil, awserr.New(ecs.ErrCodeServiceNotActiveException, "Service was not ACTIVE.", nil)
+	}
+	return svc, nil
+}
+
+func (e *Ecs) UpdateService(input *ecs.UpdateServiceInput) (*ecs.UpdateServiceOutput, error) {
+	s := e.sim
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if err := s.begin("UpdateService"); err != nil {
+		return nil, err
+	}
+	svc, err := s.activeService(input.Cluster, input.Service)
+	if err != nil {
+		return nil, err
+	}
+	es := svc.service
+	now := s.Clock.Now()
+	primary := es.Deployments[0]
+	if input.DesiredCount != nil {
+		es.DesiredCount = input.DesiredCount
+		primary.DesiredCount = input.DesiredCount
+	}
+	nextTd := primary.TaskDefinition
+	if input.TaskDefinition != nil {
+		td := s.findTaskDefinition(*input.TaskDefinition)
+		if td == nil {
+			return nil, awserr.New(ecs.ErrCodeInvalidParameterException, "TaskDefinition not found.", nil)
+		}
+		nextTd = td.TaskDefinitionArn
+	}
+	if *nextTd != *primary.TaskDefinition || aws.BoolValue(input.ForceNewDeployment) {
+		s.seq++
+		next := &ecs.Deployment{
+			Id:                       aws.String(fmt.Sprintf("ecs-svc/%019d", s.seq)),
+			Status:                   aws.String("PRIMARY"),
+			TaskDefinition:           nextTd,
+			DesiredCount:             es.DesiredCount,
+			RunningCount:             aws.Int64(0),
+			PendingCount:             aws.Int64(0),
+			LaunchType:               primary.LaunchType,
+			CapacityProviderStrategy: primary.CapacityProviderStrategy,
+			NetworkConfiguration:     primary.NetworkConfiguration,
+			PlatformVersion:          primary.PlatformVersion,
+			CreatedAt:                aws.Time(now),
+			UpdatedAt:                aws.Time(now),
+		}
+		for _, d := range es.Deployments {
+			d.Status = aws.String("ACTIVE")
+		}
+		es.Deployments = append([]*ecs.Deployment{next}, es.Deployments...)
+		es.TaskDefinition = nextTd
+	}
+	return &ecs.UpdateServiceOutput{
+		Service: copyService(es),
+	}, nil
+}
+
+func (e *Ecs) DeleteService(input *ecs.DeleteServiceInput) (*ecs.DeleteServiceOutput, error) {
+	s := e.sim
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if err := s.begin("DeleteService"); err != nil {
+		return nil, err
+	}
+	svc, err := s.activeService(input.Cluster, input.Service)
+	if err != nil {
+		return nil, err
+	}
+	es := svc.service
+	if *es.DesiredCount > 0 && !aws.BoolValue(input.Force) {
+		return nil, awserr.New(ecs.ErrCodeInvalidParameterException, "The service cannot be stopped while it is scaled above 0.", nil)
+	}
+	es.Status = aws.String("DRAINING")
+	es.DesiredCount = aws.Int64(0)
+	return &ecs.DeleteServiceOutput{
+		Service: copyService(es),
+	}, nil
+}
+
+func (e *Ecs) DescribeServices(input *ecs.DescribeServicesInput) (*ecs.DescribeServicesOutput, error) {
+	s := e.sim
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if err := s.begin("DescribeServices"); err != nil {
+		return nil, err
+	}
+	return s.describeServices(input), nil
+}
+
+func (s *Simulator) describeServices(input *ecs.DescribeServicesInput) *ecs.DescribeServicesOutput {
+	cluster := clusterName(input.Cluster)
+	ret := &ecs.DescribeServicesOutput{}
+	for _, v := range input.Services {
+		if svc := s.findService(cluster, *v); svc != nil {
+			ret.Services = append(ret.Services, copyService(svc.service))
+		} else {
+			ret.Failures = append(ret.Failures, &ecs.Failure{
+				Arn:    aws.String(fmt.Sprintf("arn:aws:ecs:%s:%s:service/%s/%s", s.Region, s.AccountId, cluster, lastSegment(*v))),
+				Reason: aws.String("MISSING"),
+			})
+		}
+	}
+	return ret
+}
+
+// SDKのwaiterと同じく、最初は待たずに確認して以降はWaiterDelayごとに時計を進めて確認する
+func (s *Simulator) wait(operation string, check func() (bool, error)) error {
+	for i := 0; i < s.WaiterMaxAttempts; i++ {
+		if i > 0 {
+			s.Clock.Advance(s.WaiterDelay)
+		}
+		s.mux.Lock()
+		err := s.begin(operation)
+		var done bool
+		if err == nil {
+			done, err = check()
+		}
+		s.mux.Unlock()
+		if err != nil {
+			return err
+		} else if done {
+			return nil
+		}
+	}
+	return WaiterTimeoutError()
+}
+
+func waiterFailure(reason string) error {
+	return awserr.New(request.WaiterResourceNotReadyErrorCode, fmt.Sprintf("failed waiting for successful resource state: %s", reason), nil)
+}
+
+func (e *Ecs) WaitUntilServicesStable(input *ecs.DescribeServicesInput) error {
+	s := e.sim
+	return s.wait("WaitUntilServicesStable", func() (bool, error) {
+		o := s.describeServices(input)
+		if len(o.Failures) > 0 {
+			return false, waiterFailure("MISSING")
+		}
+		cluster := clusterName(input.Cluster)
+		for _, v := range input.Services {
+			svc := s.findService(cluster, *v)
+			switch *svc.service.Status {
+			case "DRAINING", "INACTIVE":
+				return false, waiterFailure(*svc.service.Status)
+			}
+			if !s.isServiceStable(svc) {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+}
+
+func (e *Ecs) WaitUntilServicesInactive(input *ecs.DescribeServicesInput) error {
+	s := e.sim
+	return s.wait("WaitUntilServicesInactive", func() (bool, error) {
+		o := s.describeServices(input)
+		if len(o.Failures) > 0 {
+			return false, waiterFailure("MISSING")
+		}
+		for _, v := range o.Services {
+			if *v.Status != "INACTIVE" {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+}
+
+func (e *Ecs) WaitUntilTasksRunning(input *ecs.DescribeTasksInput) error {
+	s := e.sim
+	return s.wait("WaitUntilTasksRunning", func() (bool, error) {
+		o := s.describeTasks(input)
+		if len(o.Failures) > 0 {
+			return false, waiterFailure("MISSING")
+		}
+		for _, v := range o.Tasks {
+			switch *v.LastStatus {
+			case "STOPPED":
+				return false, waiterFailure("STOPPED")
+			case "RUNNING":
+			default:
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+}
+
+func (e *Ecs) WaitUntilTasksStopped(input *ecs.DescribeTasksInput) error {
+	s := e.sim
+	return s.wait("WaitUntilTasksStopped", func() (bool, error) {
+		o := s.describeTasks(input)
+		for _, v := range o.Tasks {
+			if *v.LastStatus != "STOPPED" {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+}
+
+func (e *Ecs) ListTasks(input *ecs.ListTasksInput) (*ecs.ListTasksOutput, error) {
+	s := e.sim
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if err := s.begin("ListTasks"); err != nil {
+		return nil, err
+	}
+	cluster := clusterName(input.Cluster)
+	desiredStatus := aws.StringValue(input.DesiredStatus)
+	if desiredStatus == "" {
+		desiredStatus = "RUNNING"
+	}
+	ret := &ecs.ListTasksOutput{}
+	for _, t := range s.tasks {
+		task := t.task
+		if clusterName(task.ClusterArn) != cluster || *task.DesiredStatus != desiredStatus {
+			continue
+		} else if input.ServiceName != nil && *task.Group != fmt.Sprintf("service:%s", lastSegment(*input.ServiceName)) {
+			continue
+		} else if input.StartedBy != nil && aws.StringValue(task.StartedBy) != *input.StartedBy {
+			continue
+		} else if input.Family != nil && *s.taskDefinitions[*task.TaskDefinitionArn].Family != *input.Family {
+			continue
+		}
+		ret.TaskArns = append(ret.TaskArns, task.TaskArn)
+	}
+	return ret, nil
+}
+
+func (e *Ecs) DescribeTasks(input *ecs.DescribeTasksInput) (*ecs.DescribeTasksOutput, error) {
+	s := e.sim
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if err := s.begin("DescribeTasks"); err != nil {
+		return nil, err
+	}
+	return s.describeTasks(input), nil
+}
+
+func (s *Simulator) describeTasks(input *ecs.DescribeTasksInput) *ecs.DescribeTasksOutput {
+	ret := &ecs.DescribeTasksOutput{}
+	for _, v := range input.Tasks {
+		if t := s.findTask(*v); t != nil {
+			ret.Tasks = append(ret.Tasks, copyTask(t.task))
+		} else {
+			ret.Failures = append(ret.Failures, &ecs.Failure{Arn: v, Reason: aws.String("MISSING")})
+		}
+	}
+	return ret
+}
+
+// サービスのタスクを止めるとサービスは次の呼び出しで新しいタスクを起動する
+func (e *Ecs) StopTask(input *ecs.StopTaskInput) (*ecs.StopTaskOutput, error) {
+	s := e.sim
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if err := s.begin("StopTask"); err != nil {
+		return nil, err
+	}
+	t := s.findTask(aws.StringValue(input.Task))
+	if t == nil {
+		return nil, awserr.New(ecs.ErrCodeInvalidParameterException, "The referenced task was not found.", nil)
+	}
+	reason := aws.StringValue(input.Reason)
+	if reason == "" {
+		reason = "Task stopped by user"
+	}
+	s.stopTask(t, s.Clock.Now(), reason)
+	return &ecs.StopTaskOutput{
+		Task: copyTask(t.task),
+	}, nil
+}
+
+func (e *Ecs) RunTask(input *ecs.RunTaskInput) (*ecs.RunTaskOutput, error) {
+	s := e.sim
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if err := s.begin("RunTask"); err != nil {
+		return nil, err
+	}
+	td := s.findTaskDefinition(aws.StringValue(input.TaskDefinition))
+	if td == nil {
+		return nil, awserr.New(ecs.ErrCodeInvalidParameterException, "TaskDefinition not found.", nil)
+	}
+	count := aws.Int64Value(input.Count)
+	if count == 0 {
+		count = 1
+	}
+	group := aws.StringValue(input.Group)
+	if group == "" {
+		group = fmt.Sprintf("family:%s", *td.Family)
+	}
+	ret := &ecs.RunTaskOutput{}
+	for i := int64(0); i < count; i++ {
+		t := s.startTask(&startTaskInput{
+			cluster:              clusterName(input.Cluster),
+			taskDefinition:       td,
+			group:                group,
+			startedBy:            input.StartedBy,
+			launchType:           input.LaunchType,
+			capacityProvider:     capacityProvider(input.CapacityProviderStrategy),
+			networkConfiguration: input.NetworkConfiguration,
+			overrides:            input.Overrides,
+		})
+		ret.Tasks = append(ret.Tasks, copyTask(t.task))
+	}
+	return ret, nil
+}
+
+func (e *Ecs) DescribeContainerInstances(input *ecs.DescribeContainerInstancesInput) (*ecs.DescribeContainerInstancesOutput, error) {
+	s := e.sim
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if err := s.begin("DescribeContainerInstances"); err != nil {
+		return nil, err
+	}
+	ret := &ecs.DescribeContainerInstancesOutput{}
+	for _, v := range input.ContainerInstances {
+		found := false
+		for _, ci := range s.instances {
+			if *ci.ContainerInstanceArn == *v {
+				ret.ContainerInstances = append(ret.ContainerInstances, ci)
+				found = true
+			}
+		}
+		if !found {
+			ret.Failures = append(ret.Failures, &ecs.Failure{Arn: v, Reason: aws.String("MISSING")})
+		}
+	}
+	return ret, nil
+}
+
+func (s *Simulator) findServiceByArn(arn string) *simService {
+	for i := len(s.services) - 1; i >= 0; i-- {
+		if *s.services[i].service.ServiceArn == arn {
+			return s.services[i]
+		}
+	}
+	return nil
+}
+
+func (e *Ecs) TagResource(input *ecs.TagResourceInput) (*ecs.TagResourceOutput, error) {
+	s := e.sim
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if err := s.begin("TagResource"); err != nil {
+		return nil, err
+	}
+	svc := s.findServiceByArn(aws.StringValue(input.ResourceArn))
+	if svc == nil {
+		return nil, awserr.New(ecs.ErrCodeResourceNotFoundException, "The specified resource could not be found.", nil)
+	}
+	for _, tag := range input.Tags {
+		replaced := false
+		for _, v := range svc.service.Tags {
+			if *v.Key == *tag.Key {
+				v.Value = tag.Value
+				replaced = true
+			}
+		}
+		if !replaced {
+			svc.service.Tags = append(svc.service.Tags, &ecs.Tag{Key: tag.Key, Value: tag.Value})
+		}
+	}
+	return &ecs.TagResourceOutput{}, nil
+}
+
+func (e *Ecs) UntagResource(input *ecs.UntagResourceInput) (*ecs.UntagResourceOutput, error) {
+	s := e.sim
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if err := s.begin("UntagResource"); err != nil {
+		return nil, err
+	}
+	svc := s.findServiceByArn(aws.StringValue(input.ResourceArn))
+	if svc == nil {
+		return nil, awserr.New(ecs.ErrCodeResourceNotFoundException, "The specified resource could not be found.", nil)
+	}
+	var tags []*ecs.Tag
+	for _, v := range svc.service.Tags {
+		removed := false
+		for _, key := range input.TagKeys {
+			removed = removed || *v.Key == *key
+		}
+		if !removed {
+			tags = append(tags, v)
+		}
+	}
+	svc.service.Tags = tags
+	return &ecs.UntagResourceOutput{}, nil
+}
+
+func (e *Ecs) ListTagsForResource(input *ecs.ListTagsForResourceInput) (*ecs.ListTagsForResourceOutput, error) {
+	s := e.sim
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if err := s.begin("ListTagsForResource"); err != nil {
+		return nil, err
+	}
+	svc := s.findServiceByArn(aws.StringValue(input.ResourceArn))
+	if svc == nil {
+		return nil, awserr.New(ecs.ErrCodeResourceNotFoundException, "The specified resource could not be found.", nil)
+	}
+	return &ecs.ListTagsForResourceOutput{
+		Tags: copyService(svc.service).Tags,
+	}, nil
+}
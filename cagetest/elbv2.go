@@ -0,0 +1,97 @@
+package cagetest
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+)
+
+// SimulatorのELBv2
+// サービスのタスクはRUNNINGになるとロードバランサーのターゲットグループに登録される
+type Elbv2 struct {
+	elbv2iface.ELBV2API
+	sim *Simulator
+}
+
+func (e *Elbv2) DescribeTargetGroups(input *elbv2.DescribeTargetGroupsInput) (*elbv2.DescribeTargetGroupsOutput, error) {
+	s := e.sim
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if err := s.begin("DescribeTargetGroups"); err != nil {
+		return nil, err
+	}
+	ret := &elbv2.DescribeTargetGroupsOutput{}
+	for _, v := range input.TargetGroupArns {
+		tg, ok := s.targetGroups[*v]
+		if !ok {
+			return nil, awserr.New(elbv2.ErrCodeTargetGroupNotFoundException, "One or more target groups not found", nil)
+		}
+		ret.TargetGroups = append(ret.TargetGroups, copyTargetGroup(tg.group))
+	}
+	for _, v := range input.Names {
+		found := false
+		for _, tg := range s.targetGroups {
+			if *tg.group.TargetGroupName == *v {
+				ret.TargetGroups = append(ret.TargetGroups, copyTargetGroup(tg.group))
+				found = true
+			}
+		}
+		if !found {
+			return nil, awserr.New(elbv2.ErrCodeTargetGroupNotFoundException, "One or more target groups not found", nil)
+		}
+	}
+	return ret, nil
+}
+
+// Targetsを指定した場合、登録されていないターゲットはunusedになる
+func (e *Elbv2) DescribeTargetHealth(input *elbv2.DescribeTargetHealthInput) (*elbv2.DescribeTargetHealthOutput, error) {
+	s := e.sim
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if err := s.begin("DescribeTargetHealth"); err != nil {
+		return nil, err
+	}
+	tg, ok := s.targetGroups[aws.StringValue(input.TargetGroupArn)]
+	if !ok {
+		return nil, awserr.New(elbv2.ErrCodeTargetGroupNotFoundException, "One or more target groups not found", nil)
+	}
+	now := s.Clock.Now()
+	ret := &elbv2.DescribeTargetHealthOutput{}
+	describe := func(v *simTarget) *elbv2.TargetHealthDescription {
+		state, reason := s.targetState(tg, v, now)
+		health := &elbv2.TargetHealth{State: aws.String(state)}
+		if reason != "" {
+			health.Reason = aws.String(reason)
+		}
+		return &elbv2.TargetHealthDescription{
+			Target:       &elbv2.TargetDescription{Id: aws.String(v.id), Port: aws.Int64(v.port)},
+			TargetHealth: health,
+		}
+	}
+	if len(input.Targets) == 0 {
+		for _, v := range tg.targets {
+			ret.TargetHealthDescriptions = append(ret.TargetHealthDescriptions, describe(v))
+		}
+		return ret, nil
+	}
+	for _, target := range input.Targets {
+		found := false
+		for _, v := range tg.targets {
+			if v.id == aws.StringValue(target.Id) && (target.Port == nil || v.port == *target.Port) {
+				ret.TargetHealthDescriptions = append(ret.TargetHealthDescriptions, describe(v))
+				found = true
+			}
+		}
+		if !found {
+			ret.TargetHealthDescriptions = append(ret.TargetHealthDescriptions, &elbv2.TargetHealthDescription{
+				Target: target,
+				TargetHealth: &elbv2.TargetHealth{
+					State:  aws.String(elbv2.TargetHealthStateEnumUnused),
+					Reason: aws.String(elbv2.TargetHealthReasonEnumTargetNotRegistered),
+				},
+			})
+		}
+	}
+	return ret, nil
+}
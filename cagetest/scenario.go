@@ -0,0 +1,121 @@
+package cagetest
+
+import (
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"time"
+)
+
+// ロールアウトするタスク定義に起きる障害の台本
+// メソッドをつなげて組み立て、Apply でシミュレーターに仕込む
+//
+//	cagetest.NewScenario("flapping").
+//		TargetHealth(cagetest.Initial(30*time.Second), cagetest.Healthy(15*time.Second), cagetest.Unhealthy()).
+//		Throttle("CreateService", 2)
+type Scenario struct {
+	Name      string
+	behaviors []func(b *TaskBehavior)
+	faults    []fault
+}
+
+func NewScenario(name string) *Scenario {
+	return &Scenario{Name: name}
+}
+
+// PENDINGからRUNNINGになるまでの時間
+func (sc *Scenario) StartAfter(d time.Duration) *Scenario {
+	sc.behaviors = append(sc.behaviors, func(b *TaskBehavior) {
+		b.StartDelay = d
+	})
+	return sc
+}
+
+// ターゲットのヘルス状態の遷移
+func (sc *Scenario) TargetHealth(steps ...HealthStep) *Scenario {
+	sc.behaviors = append(sc.behaviors, func(b *TaskBehavior) {
+		b.TargetHealth = steps
+	})
+	return sc
+}
+
+// RUNNINGになってからafter後にexitCodeで落ちる
+func (sc *Scenario) Crash(after time.Duration, exitCode int64) *Scenario {
+	sc.behaviors = append(sc.behaviors, func(b *TaskBehavior) {
+		b.StopAfter = after
+		b.ExitCode = exitCode
+	})
+	return sc
+}
+
+// operationの次のtimes回の呼び出しでerrを返す。timesが0以下なら常に返す
+func (sc *Scenario) Fail(operation string, err error, times int) *Scenario {
+	sc.faults = append(sc.faults, fault{operation: operation, err: err, remaining: times})
+	return sc
+}
+
+func (sc *Scenario) Throttle(operation string, times int) *Scenario {
+	return sc.Fail(operation, ThrottlingError(), times)
+}
+
+// operation (例: "WaitUntilServicesStable") の待機が次のtimes回タイムアウトする
+func (sc *Scenario) WaiterTimeout(operation string, times int) *Scenario {
+	return sc.Fail(operation, WaiterTimeoutError(), times)
+}
+
+// taskDefinitionArnのタスクの振る舞いと注入するエラーをシミュレーターに設定する
+func (sc *Scenario) Apply(s *Simulator, taskDefinitionArn string) {
+	b := s.DefaultTaskBehavior
+	for _, f := range sc.behaviors {
+		f(&b)
+	}
+	s.SetTaskBehavior(taskDefinitionArn, b)
+	for _, f := range sc.faults {
+		s.InjectError(f.operation, f.err, f.remaining)
+	}
+}
+
+func Initial(d time.Duration) HealthStep {
+	return HealthStep{State: elbv2.TargetHealthStateEnumInitial, Duration: d}
+}
+
+func Healthy(d time.Duration) HealthStep {
+	return HealthStep{State: elbv2.TargetHealthStateEnumHealthy, Duration: d}
+}
+
+// 最後に置くとunhealthyのまま続く
+func Unhealthy() HealthStep {
+	return HealthStep{State: elbv2.TargetHealthStateEnumUnhealthy}
+}
+
+// test-containerのイメージと同じ振る舞いのシナリオ
+// up-but-slowはヘルスチェックが200を返すのでターゲットはhealthyになる
+// up-but-buggyはリクエストの半分が500になるだけでヘルス状態はhealthyと変わらない
+// シミュレーターはHTTPに応答しないので再現できず、シナリオは用意しない
+
+func HealthyScenario() *Scenario {
+	return NewScenario("healthy")
+}
+
+// ヘルスチェックが500を返す
+func UnhealthyScenario() *Scenario {
+	return NewScenario("unhealthy").
+		TargetHealth(Initial(30*time.Second), Unhealthy())
+}
+
+// レスポンスは遅いがヘルスチェックは通る
+func UpButSlowScenario() *Scenario {
+	return NewScenario("up-but-slow").
+		TargetHealth(Initial(90*time.Second), Healthy(0))
+}
+
+// 起動してすぐに落ちる
+func UpButExitScenario() *Scenario {
+	return NewScenario("up-but-exit").
+		StartAfter(10*time.Second).
+		Crash(time.Second, 1)
+}
+
+// ヘルスチェックを通った後、1分で落ちる
+func UpAndExitScenario() *Scenario {
+	return NewScenario("up-and-exit").
+		Crash(time.Minute, 1)
+}
@@ -0,0 +1,39 @@
+package cagetest
+
+import (
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestScenario_RollOut(t *testing.T) {
+	for _, v := range []struct {
+		scenario      *Scenario
+		failed        bool
+		serviceIntact bool
+	}{
+		{HealthyScenario(), false, false},
+		{UnhealthyScenario(), true, true},
+		{UpButSlowScenario(), false, false},
+		{UpButExitScenario(), true, true},
+		// カナリアがhealthyになった時点で判定するので、その後に落ちるタスクやヘルス状態の悪化は検知できない
+		{UpAndExitScenario(), false, false},
+		{NewScenario("flapping").TargetHealth(Initial(30*time.Second), Healthy(10*time.Second), Unhealthy()), false, false},
+		// リトライはSDKのセッションでするので、シミュレーターのスロットリングはそのままエラーになる
+		{NewScenario("throttled").Throttle("CreateService", 1), true, true},
+		{NewScenario("update-rejected").Fail("UpdateService", awserr.New(ecs.ErrCodeAccessDeniedException, "", nil), 1), true, false},
+		{NewScenario("canary-timeout").WaiterTimeout("WaitUntilServicesStable", 1), true, true},
+		{NewScenario("never-registered").TargetHealth(HealthStep{State: "unused"}), true, true},
+	} {
+		v := v
+		t.Run(v.scenario.Name, func(t *testing.T) {
+			f := setup(t, 2, "FARGATE", "awsvpc", "ip")
+			v.scenario.Apply(f.sim, *f.next.TaskDefinitionArn)
+			result := f.envars().RollOut(f.sim.Context())
+			assert.Equal(t, v.failed, result.Error != nil, "%v", result.Error)
+			assert.Equal(t, v.serviceIntact, result.ServiceIntact)
+		})
+	}
+}
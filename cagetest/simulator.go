@@ -0,0 +1,736 @@
+// cagetestはcageを使ったロールアウトのテストをAWSなしで書くためのECSとELBv2のシミュレーター
+//
+// Simulatorはメモリ上にサービス、タスク、タスク定義、ターゲットグループを持ち、
+// 時計が進むにつれてタスクの起動、ターゲットのヘルス状態の遷移、サービスのデプロイの収束を再現する
+// 実装していないAPIを呼ぶとpanicする
+package cagetest
+
+import (
+	"fmt"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/loilo-inc/canarycage"
+	"strings"
+	"sync"
+	"time"
+)
+
+const kDefaultCluster = "default"
+const kFirstDynamicHostPort = 32768
+
+// タスクの振る舞い。タスク定義ごとに SetTaskBehavior で変えられる
+type TaskBehavior struct {
+	// PENDINGからRUNNINGになるまでの時間
+	StartDelay time.Duration
+	// ターゲットグループに登録されてからinitialの状態が続く時間
+	HealthyAfter time.Duration
+	// trueならヘルスチェックを通らずinitialの後にunhealthyになる
+	Unhealthy bool
+	// 0より大きければRUNNINGになってからこの時間で停止する
+	StopAfter time.Duration
+	// 停止したときのコンテナの終了コード
+	ExitCode int64
+	// ターゲットグループに登録されてからのヘルス状態の台本。指定するとHealthyAfterとUnhealthyより優先する
+	// 最後の状態がそのまま続く
+	TargetHealth []HealthStep
+}
+
+// Durationの間ターゲットのヘルス状態がStateになる
+type HealthStep struct {
+	State    string
+	Duration time.Duration
+}
+
+type TargetGroupConfig struct {
+	Name string
+	// ip | instance (default: ip)
+	TargetType string
+	// HTTP | HTTPS | TCP | UDP | TLS | TCP_UDP (default: HTTP)
+	Protocol string
+	// trueならヘルスチェックがなくターゲットはunavailableになる
+	HealthCheckDisabled bool
+	// 登録解除してからdrainingの状態が続く時間
+	DeregistrationDelay time.Duration
+}
+
+type Simulator struct {
+	Clock     *Clock
+	Region    string
+	AccountId string
+	// SetTaskBehavior で指定されていないタスク定義のタスクの振る舞い
+	DefaultTaskBehavior TaskBehavior
+	// WaitUntil* が状態を確認する間隔と最大回数
+	WaiterDelay       time.Duration
+	WaiterMaxAttempts int
+
+	taskDefinitions map[string]*ecs.TaskDefinition
+	revisions       map[string]int64
+	behaviors       map[string]TaskBehavior
+	services        []*simService
+	tasks           []*simTask
+	targetGroups    map[string]*simTargetGroup
+	instances       map[string]*ecs.ContainerInstance
+	faults          []*fault
+	seq             int64
+	hostPort        int64
+	mux             sync.Mutex
+}
+
+type simService struct {
+	cluster string
+	service *ecs.Service
+}
+
+type simTask struct {
+	task       *ecs.Task
+	service    *simService
+	deployment string
+	behavior   TaskBehavior
+	createdAt  time.Time
+	runningAt  time.Time
+	stopped    bool
+	ip         string
+}
+
+type simTargetGroup struct {
+	group               *elbv2.TargetGroup
+	deregistrationDelay time.Duration
+	targets             []*simTarget
+}
+
+type simTarget struct {
+	id             string
+	port           int64
+	task           *simTask
+	registeredAt   time.Time
+	deregisteredAt time.Time
+}
+
+type fault struct {
+	operation string
+	err       error
+	remaining int
+}
+
+func NewSimulator() *Simulator {
+	return &Simulator{
+		Clock:     NewClock(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+		Region:    "us-west-2",
+		AccountId: "123456789012",
+		DefaultTaskBehavior: TaskBehavior{
+			StartDelay:   time.Duration(30) * time.Second,
+			HealthyAfter: time.Duration(30) * time.Second,
+		},
+		WaiterDelay:       time.Duration(15) * time.Second,
+		WaiterMaxAttempts: 40,
+		taskDefinitions:   make(map[string]*ecs.TaskDefinition),
+		revisions:         make(map[string]int64),
+		behaviors:         make(map[string]TaskBehavior),
+		targetGroups:      make(map[string]*simTargetGroup),
+		instances:         make(map[string]*ecs.ContainerInstance),
+		hostPort:          kFirstDynamicHostPort,
+	}
+}
+
+func (s *Simulator) Ecs() *Ecs {
+	return &Ecs{sim: s}
+}
+
+func (s *Simulator) Elbv2() *Elbv2 {
+	return &Elbv2{sim: s}
+}
+
+// EcsとAlbにシミュレーターをつないだcage.Context
+// cageの待ち時間はシミュレーターの時計で進む
+func (s *Simulator) Context() *cage.Context {
+	return &cage.Context{
+		Ecs:   s.Ecs(),
+		Alb:   s.Elbv2(),
+		Clock: s.Clock,
+	}
+}
+
+func (s *Simulator) SetTaskBehavior(taskDefinitionArn string, behavior TaskBehavior) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.behaviors[taskDefinitionArn] = behavior
+}
+
+// operation (例: "CreateService") の次のtimes回の呼び出しでerrを返す。timesが0以下なら常に返す
+func (s *Simulator) InjectError(operation string, err error, times int) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if times <= 0 {
+		times = -1
+	}
+	s.faults = append(s.faults, &fault{operation: operation, err: err, remaining: times})
+}
+
+func (s *Simulator) ClearErrors() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.faults = nil
+}
+
+func ThrottlingError() error {
+	return awserr.New("ThrottlingException", "Rate exceeded", nil)
+}
+
+// WaitUntil* が最大回数まで待っても状態が変わらなかったときのエラー
+func WaiterTimeoutError() error {
+	return awserr.New(request.WaiterResourceNotReadyErrorCode, "exceeded wait attempts", nil)
+}
+
+func (s *Simulator) AddTargetGroup(config TargetGroupConfig) *elbv2.TargetGroup {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.seq++
+	if config.Name == "" {
+		config.Name = fmt.Sprintf("tg-%d", s.seq)
+	}
+	if config.TargetType == "" {
+		config.TargetType = elbv2.TargetTypeEnumIp
+	}
+	if config.Protocol == "" {
+		config.Protocol = elbv2.ProtocolEnumHttp
+	}
+	tg := &elbv2.TargetGroup{
+		TargetGroupName: aws.String(config.Name),
+		TargetGroupArn: aws.String(fmt.Sprintf(
+			"arn:aws:elasticloadbalancing:%s:%s:targetgroup/%s/%016x", s.Region, s.AccountId, config.Name, s.seq,
+		)),
+		TargetType:         aws.String(config.TargetType),
+		Protocol:           aws.String(config.Protocol),
+		HealthCheckEnabled: aws.Bool(!config.HealthCheckDisabled),
+	}
+	s.targetGroups[*tg.TargetGroupArn] = &simTargetGroup{
+		group:               tg,
+		deregistrationDelay: config.DeregistrationDelay,
+	}
+	return copyTargetGroup(tg)
+}
+
+// 現在の状態のコピーを返す。見つからなければnil
+func (s *Simulator) Service(cluster string, name string) *ecs.Service {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.reconcile()
+	if svc := s.findService(cluster, name); svc != nil {
+		return copyService(svc.service)
+	}
+	return nil
+}
+
+// サービスの停止していないタスク
+func (s *Simulator) ServiceTasks(cluster string, name string) []*ecs.Task {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.reconcile()
+	var ret []*ecs.Task
+	svc := s.findService(cluster, name)
+	for _, t := range s.tasks {
+		if svc != nil && t.service == svc && !t.stopped {
+			ret = append(ret, copyTask(t.task))
+		}
+	}
+	return ret
+}
+
+func clusterName(cluster *string) string {
+	if isEmpty(cluster) {
+		return kDefaultCluster
+	}
+	return lastSegment(*cluster)
+}
+
+func lastSegment(str string) string {
+	return str[strings.LastIndex(str, "/")+1:]
+}
+
+func isEmpty(o *string) bool {
+	return o == nil || *o == ""
+}
+
+// APIの呼び出しの最初に呼ぶ。注入されたエラーを返し、時計に合わせて状態を進める
+func (s *Simulator) begin(operation string) error {
+	for i, f := range s.faults {
+		if f.operation != operation {
+			continue
+		}
+		if f.remaining > 0 {
+			f.remaining--
+			if f.remaining == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+		return f.err
+	}
+	s.reconcile()
+	return nil
+}
+
+func (s *Simulator) findService(cluster string, name string) *simService {
+	name = lastSegment(name)
+	// 削除されたサービスと同じ名前のサービスは作り直せるので新しいものから探す
+	for i := len(s.services) - 1; i >= 0; i-- {
+		v := s.services[i]
+		if v.cluster == cluster && *v.service.ServiceName == name {
+			return v
+		}
+	}
+	return nil
+}
+
+func (s *Simulator) findTask(arn string) *simTask {
+	for _, t := range s.tasks {
+		if *t.task.TaskArn == arn || lastSegment(*t.task.TaskArn) == arn {
+			return t
+		}
+	}
+	return nil
+}
+
+// arn, family:revision, family (最新) のいずれか
+func (s *Simulator) findTaskDefinition(key string) *ecs.TaskDefinition {
+	if td, ok := s.taskDefinitions[key]; ok {
+		return td
+	}
+	key = lastSegment(key)
+	if !strings.Contains(key, ":") {
+		key = fmt.Sprintf("%s:%d", key, s.revisions[key])
+	}
+	return s.taskDefinitions[s.taskDefinitionArn(key)]
+}
+
+func (s *Simulator) taskDefinitionArn(familyRevision string) string {
+	return fmt.Sprintf("arn:aws:ecs:%s:%s:task-definition/%s", s.Region, s.AccountId, familyRevision)
+}
+
+func (s *Simulator) clusterArn(cluster string) string {
+	return fmt.Sprintf("arn:aws:ecs:%s:%s:cluster/%s", s.Region, s.AccountId, cluster)
+}
+
+func (s *Simulator) containerInstance(cluster string) *ecs.ContainerInstance {
+	if ci, ok := s.instances[cluster]; ok {
+		return ci
+	}
+	s.seq++
+	ci := &ecs.ContainerInstance{
+		ContainerInstanceArn: aws.String(fmt.Sprintf(
+			"arn:aws:ecs:%s:%s:container-instance/%s/%032x", s.Region, s.AccountId, cluster, s.seq,
+		)),
+		Ec2InstanceId: aws.String(fmt.Sprintf("i-%017x", s.seq)),
+		Status:        aws.String("ACTIVE"),
+	}
+	s.instances[cluster] = ci
+	return ci
+}
+
+type startTaskInput struct {
+	cluster              string
+	service              *simService
+	deployment           string
+	taskDefinition       *ecs.TaskDefinition
+	group                string
+	startedBy            *string
+	launchType           *string
+	capacityProvider     *string
+	loadBalancers        []*ecs.LoadBalancer
+	networkConfiguration *ecs.NetworkConfiguration
+	overrides            *ecs.TaskOverride
+}
+
+func (s *Simulator) startTask(input *startTaskInput) *simTask {
+	now := s.Clock.Now()
+	s.seq++
+	id := fmt.Sprintf("%032x", s.seq)
+	td := input.taskDefinition
+	networkMode := aws.StringValue(td.NetworkMode)
+	if networkMode == "" {
+		networkMode = ecs.NetworkModeBridge
+	}
+	launchType := aws.StringValue(input.launchType)
+	if p := aws.StringValue(input.capacityProvider); p != "" {
+		launchType = ecs.LaunchTypeEc2
+		if p == "FARGATE" || p == "FARGATE_SPOT" {
+			launchType = ecs.LaunchTypeFargate
+		}
+	}
+	task := &ecs.Task{
+		TaskArn:              aws.String(fmt.Sprintf("arn:aws:ecs:%s:%s:task/%s/%s", s.Region, s.AccountId, input.cluster, id)),
+		ClusterArn:           aws.String(s.clusterArn(input.cluster)),
+		TaskDefinitionArn:    td.TaskDefinitionArn,
+		Group:                aws.String(input.group),
+		StartedBy:            input.startedBy,
+		LaunchType:           input.launchType,
+		CapacityProviderName: input.capacityProvider,
+		LastStatus:           aws.String("PENDING"),
+		DesiredStatus:        aws.String("RUNNING"),
+		CreatedAt:            aws.Time(now),
+		Overrides:            input.overrides,
+	}
+	t := &simTask{
+		task:       task,
+		service:    input.service,
+		deployment: input.deployment,
+		behavior:   s.DefaultTaskBehavior,
+		createdAt:  now,
+	}
+	if b, ok := s.behaviors[*td.TaskDefinitionArn]; ok {
+		t.behavior = b
+	}
+	if launchType == ecs.LaunchTypeFargate || networkMode == ecs.NetworkModeAwsvpc {
+		t.ip = fmt.Sprintf("10.0.%d.%d", (s.seq/250)%250, s.seq%250+1)
+		task.Attachments = []*ecs.Attachment{{
+			Id:     aws.String(fmt.Sprintf("%032x", s.seq)),
+			Type:   aws.String("ElasticNetworkInterface"),
+			Status: aws.String("ATTACHED"),
+			Details: []*ecs.KeyValuePair{
+				{Name: aws.String("networkInterfaceId"), Value: aws.String(fmt.Sprintf("eni-%017x", s.seq))},
+				{Name: aws.String("privateIPv4Address"), Value: aws.String(t.ip)},
+			},
+		}}
+	}
+	if launchType != ecs.LaunchTypeFargate {
+		task.ContainerInstanceArn = s.containerInstance(input.cluster).ContainerInstanceArn
+	}
+	// ロードバランサーのコンテナがタスク定義になくてもポートを持つコンテナとして扱う
+	defs := td.ContainerDefinitions
+	for _, lb := range input.loadBalancers {
+		found := false
+		for _, d := range defs {
+			if aws.StringValue(d.Name) == aws.StringValue(lb.ContainerName) {
+				found = true
+			}
+		}
+		if !found {
+			defs = append(defs, &ecs.ContainerDefinition{
+				Name:         lb.ContainerName,
+				PortMappings: []*ecs.PortMapping{{ContainerPort: lb.ContainerPort}},
+			})
+		}
+	}
+	for _, d := range defs {
+		c := &ecs.Container{
+			Name:       d.Name,
+			TaskArn:    task.TaskArn,
+			LastStatus: aws.String("PENDING"),
+		}
+		if networkMode != ecs.NetworkModeAwsvpc {
+			for _, m := range d.PortMappings {
+				hostPort := aws.Int64Value(m.HostPort)
+				if networkMode == ecs.NetworkModeHost {
+					hostPort = aws.Int64Value(m.ContainerPort)
+				} else if hostPort == 0 {
+					// bridgeはホストポートが動的に割り当てられる
+					hostPort = s.hostPort
+					s.hostPort++
+				}
+				c.NetworkBindings = append(c.NetworkBindings, &ecs.NetworkBinding{
+					ContainerPort: m.ContainerPort,
+					HostPort:      aws.Int64(hostPort),
+					Protocol:      aws.String("tcp"),
+				})
+			}
+		}
+		task.Containers = append(task.Containers, c)
+	}
+	s.tasks = append(s.tasks, t)
+	return t
+}
+
+func (s *Simulator) stopTask(t *simTask, at time.Time, reason string) {
+	if t.stopped {
+		return
+	}
+	t.stopped = true
+	t.task.LastStatus = aws.String("STOPPED")
+	t.task.DesiredStatus = aws.String("STOPPED")
+	t.task.StoppedAt = aws.Time(at)
+	t.task.StoppedReason = aws.String(reason)
+	for _, c := range t.task.Containers {
+		c.LastStatus = aws.String("STOPPED")
+		if !t.runningAt.IsZero() {
+			c.ExitCode = aws.Int64(t.behavior.ExitCode)
+		}
+	}
+	for _, tg := range s.targetGroups {
+		for _, v := range tg.targets {
+			if v.task == t && v.deregisteredAt.IsZero() {
+				v.deregisteredAt = at
+			}
+		}
+	}
+}
+
+// サービスのロードバランサーのターゲットグループにタスクを登録する
+func (s *Simulator) registerTargets(t *simTask, at time.Time) {
+	if t.service == nil {
+		return
+	}
+	for _, lb := range t.service.service.LoadBalancers {
+		tg, ok := s.targetGroups[aws.StringValue(lb.TargetGroupArn)]
+		if !ok {
+			continue
+		}
+		target := &simTarget{task: t, registeredAt: at}
+		if *tg.group.TargetType == elbv2.TargetTypeEnumIp {
+			if t.ip == "" {
+				continue
+			}
+			target.id = t.ip
+			target.port = aws.Int64Value(lb.ContainerPort)
+		} else {
+			if t.task.ContainerInstanceArn == nil {
+				continue
+			}
+			target.id = *s.containerInstance(t.service.cluster).Ec2InstanceId
+			for _, c := range t.task.Containers {
+				if aws.StringValue(c.Name) != aws.StringValue(lb.ContainerName) {
+					continue
+				}
+				for _, b := range c.NetworkBindings {
+					if aws.Int64Value(b.ContainerPort) == aws.Int64Value(lb.ContainerPort) {
+						target.port = *b.HostPort
+					}
+				}
+			}
+		}
+		tg.targets = append(tg.targets, target)
+	}
+}
+
+func (s *Simulator) targetState(tg *simTargetGroup, target *simTarget, now time.Time) (string, string) {
+	if !target.deregisteredAt.IsZero() {
+		return elbv2.TargetHealthStateEnumDraining, elbv2.TargetHealthReasonEnumTargetDeregistrationInProgress
+	} else if !aws.BoolValue(tg.group.HealthCheckEnabled) {
+		return elbv2.TargetHealthStateEnumUnavailable, elbv2.TargetHealthReasonEnumTargetHealthCheckDisabled
+	} else if steps := target.task.behavior.TargetHealth; len(steps) > 0 {
+		return scriptedState(steps, now.Sub(target.registeredAt))
+	} else if now.Before(target.registeredAt.Add(target.task.behavior.HealthyAfter)) {
+		return elbv2.TargetHealthStateEnumInitial, elbv2.TargetHealthReasonEnumElbInitialHealthChecking
+	} else if target.task.behavior.Unhealthy {
+		return elbv2.TargetHealthStateEnumUnhealthy, elbv2.TargetHealthReasonEnumTargetFailedHealthChecks
+	}
+	return elbv2.TargetHealthStateEnumHealthy, ""
+}
+
+func scriptedState(steps []HealthStep, elapsed time.Duration) (string, string) {
+	step := steps[len(steps)-1]
+	for _, v := range steps {
+		if elapsed < v.Duration {
+			step = v
+			break
+		}
+		elapsed -= v.Duration
+	}
+	switch step.State {
+	case elbv2.TargetHealthStateEnumInitial:
+		return step.State, elbv2.TargetHealthReasonEnumElbInitialHealthChecking
+	case elbv2.TargetHealthStateEnumUnhealthy:
+		return step.State, elbv2.TargetHealthReasonEnumTargetFailedHealthChecks
+	case elbv2.TargetHealthStateEnumUnused:
+		return step.State, elbv2.TargetHealthReasonEnumTargetNotInUse
+	case elbv2.TargetHealthStateEnumUnavailable:
+		return step.State, elbv2.TargetHealthReasonEnumTargetHealthCheckDisabled
+	case elbv2.TargetHealthStateEnumDraining:
+		return step.State, elbv2.TargetHealthReasonEnumTargetDeregistrationInProgress
+	}
+	return step.State, ""
+}
+
+// タスクがロードバランサーのヘルスチェックを通っているか。ロードバランサーがなければRUNNINGならよい
+func (s *Simulator) isTaskHealthy(t *simTask, now time.Time) bool {
+	if t.stopped || t.runningAt.IsZero() {
+		return false
+	}
+	for _, tg := range s.targetGroups {
+		for _, v := range tg.targets {
+			if v.task != t {
+				continue
+			}
+			state, _ := s.targetState(tg, v, now)
+			if state != elbv2.TargetHealthStateEnumHealthy && state != elbv2.TargetHealthStateEnumUnavailable {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// 時計に合わせてタスク、ターゲット、サービスの状態を進める
+func (s *Simulator) reconcile() {
+	now := s.Clock.Now()
+	for _, t := range s.tasks {
+		s.progressTask(t, now)
+	}
+	for _, tg := range s.targetGroups {
+		var targets []*simTarget
+		for _, v := range tg.targets {
+			if v.deregisteredAt.IsZero() || now.Before(v.deregisteredAt.Add(tg.deregistrationDelay)) {
+				targets = append(targets, v)
+			}
+		}
+		tg.targets = targets
+	}
+	for _, svc := range s.services {
+		s.reconcileService(svc, now)
+	}
+}
+
+func (s *Simulator) progressTask(t *simTask, now time.Time) {
+	if t.stopped {
+		return
+	}
+	if t.runningAt.IsZero() {
+		at := t.createdAt.Add(t.behavior.StartDelay)
+		if now.Before(at) {
+			return
+		}
+		t.runningAt = at
+		t.task.LastStatus = aws.String("RUNNING")
+		t.task.StartedAt = aws.Time(at)
+		for _, c := range t.task.Containers {
+			c.LastStatus = aws.String("RUNNING")
+		}
+		s.registerTargets(t, at)
+	}
+	if t.behavior.StopAfter > 0 {
+		at := t.runningAt.Add(t.behavior.StopAfter)
+		if !now.Before(at) {
+			s.stopTask(t, at, "Essential container in task exited")
+		}
+	}
+}
+
+func (s *Simulator) serviceTasks(svc *simService, deployment string) []*simTask {
+	var ret []*simTask
+	for _, t := range s.tasks {
+		if t.service == svc && !t.stopped && (deployment == "" || t.deployment == deployment) {
+			ret = append(ret, t)
+		}
+	}
+	return ret
+}
+
+func (s *Simulator) reconcileService(svc *simService, now time.Time) {
+	es := svc.service
+	switch *es.Status {
+	case "INACTIVE":
+		return
+	case "DRAINING":
+		for _, t := range s.serviceTasks(svc, "") {
+			s.stopTask(t, now, "Scaling activity initiated by (deployment ecs-svc)")
+		}
+		draining := false
+		for _, tg := range s.targetGroups {
+			for _, v := range tg.targets {
+				draining = draining || v.task.service == svc
+			}
+		}
+		if !draining {
+			es.Status = aws.String("INACTIVE")
+			es.Deployments = nil
+		}
+		s.updateCounts(svc)
+		return
+	}
+	primary := es.Deployments[0]
+	tasks := s.serviceTasks(svc, *primary.Id)
+	td := s.taskDefinitions[*primary.TaskDefinition]
+	for i := int64(len(tasks)); i < *es.DesiredCount; i++ {
+		tasks = append(tasks, s.startTask(&startTaskInput{
+			cluster:              svc.cluster,
+			service:              svc,
+			deployment:           *primary.Id,
+			taskDefinition:       td,
+			group:                fmt.Sprintf("service:%s", *es.ServiceName),
+			startedBy:            primary.Id,
+			launchType:           primary.LaunchType,
+			capacityProvider:     capacityProvider(primary.CapacityProviderStrategy),
+			loadBalancers:        es.LoadBalancers,
+			networkConfiguration: primary.NetworkConfiguration,
+		}))
+	}
+	for i := *es.DesiredCount; i < int64(len(tasks)); i++ {
+		s.stopTask(tasks[i], now, "Scaling activity initiated by (deployment ecs-svc)")
+	}
+	// 新しいデプロイのタスクが全て健康になったら古いデプロイのタスクを止める
+	if len(es.Deployments) > 1 {
+		ready := true
+		for _, t := range s.serviceTasks(svc, *primary.Id) {
+			ready = ready && s.isTaskHealthy(t, now)
+		}
+		if ready {
+			for _, d := range es.Deployments[1:] {
+				for _, t := range s.serviceTasks(svc, *d.Id) {
+					s.stopTask(t, now, "Scaling activity initiated by (deployment ecs-svc)")
+				}
+			}
+			es.Deployments = es.Deployments[:1]
+			primary.UpdatedAt = aws.Time(now)
+		}
+	}
+	s.updateCounts(svc)
+}
+
+func (s *Simulator) updateCounts(svc *simService) {
+	es := svc.service
+	var running, pending int64
+	for _, d := range es.Deployments {
+		var r, p int64
+		for _, t := range s.serviceTasks(svc, *d.Id) {
+			if t.runningAt.IsZero() {
+				p++
+			} else {
+				r++
+			}
+		}
+		d.RunningCount = aws.Int64(r)
+		d.PendingCount = aws.Int64(p)
+	}
+	for _, t := range s.serviceTasks(svc, "") {
+		if t.runningAt.IsZero() {
+			pending++
+		} else {
+			running++
+		}
+	}
+	es.RunningCount = aws.Int64(running)
+	es.PendingCount = aws.Int64(pending)
+}
+
+func (s *Simulator) isServiceStable(svc *simService) bool {
+	es := svc.service
+	return *es.Status == "ACTIVE" &&
+		len(es.Deployments) == 1 &&
+		*es.RunningCount == *es.DesiredCount &&
+		*es.PendingCount == 0
+}
+
+func capacityProvider(strategy []*ecs.CapacityProviderStrategyItem) *string {
+	if len(strategy) == 0 {
+		return nil
+	}
+	return strategy[0].CapacityProvider
+}
+
+func copyService(o *ecs.Service) *ecs.Service {
+	return awsutil.CopyOf(o).(*ecs.Service)
+}
+
+func copyTask(o *ecs.Task) *ecs.Task {
+	return awsutil.CopyOf(o).(*ecs.Task)
+}
+
+func copyTaskDefinition(o *ecs.TaskDefinition) *ecs.TaskDefinition {
+	return awsutil.CopyOf(o).(*ecs.TaskDefinition)
+}
+
+func copyTargetGroup(o *elbv2.TargetGroup) *elbv2.TargetGroup {
+	return awsutil.CopyOf(o).(*elbv2.TargetGroup)
+}
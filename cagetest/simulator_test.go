@@ -0,0 +1,240 @@
+package cagetest
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/loilo-inc/canarycage"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type fixture struct {
+	sim         *Simulator
+	targetGroup *elbv2.TargetGroup
+	current     *ecs.TaskDefinition
+	next        *ecs.TaskDefinition
+}
+
+func registerTaskDefinition(t *testing.T, sim *Simulator, networkMode string) *ecs.TaskDefinition {
+	o, err := sim.Ecs().RegisterTaskDefinition(&ecs.RegisterTaskDefinitionInput{
+		Family:      aws.String("app"),
+		NetworkMode: aws.String(networkMode),
+		ContainerDefinitions: []*ecs.ContainerDefinition{{
+			Name:         aws.String("app"),
+			PortMappings: []*ecs.PortMapping{{ContainerPort: aws.Int64(80)}},
+		}},
+	})
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	return o.TaskDefinition
+}
+
+func setup(t *testing.T, desiredCount int64, launchType string, networkMode string, targetType string) *fixture {
+	sim := NewSimulator()
+	f := &fixture{
+		sim:         sim,
+		targetGroup: sim.AddTargetGroup(TargetGroupConfig{Name: "app", TargetType: targetType}),
+		current:     registerTaskDefinition(t, sim, networkMode),
+		next:        registerTaskDefinition(t, sim, networkMode),
+	}
+	if _, err := sim.Ecs().CreateService(&ecs.CreateServiceInput{
+		Cluster:        aws.String("cluster"),
+		ServiceName:    aws.String("app"),
+		TaskDefinition: f.current.TaskDefinitionArn,
+		DesiredCount:   aws.Int64(desiredCount),
+		LaunchType:     aws.String(launchType),
+		LoadBalancers: []*ecs.LoadBalancer{{
+			TargetGroupArn: f.targetGroup.TargetGroupArn,
+			ContainerName:  aws.String("app"),
+			ContainerPort:  aws.Int64(80),
+		}},
+	}); err != nil {
+		t.Fatalf(err.Error())
+	}
+	if err := sim.Ecs().WaitUntilServicesStable(&ecs.DescribeServicesInput{
+		Cluster:  aws.String("cluster"),
+		Services: []*string{aws.String("app")},
+	}); err != nil {
+		t.Fatalf(err.Error())
+	}
+	return f
+}
+
+func (f *fixture) envars() *cage.Envars {
+	envars := &cage.Envars{
+		Cluster:           aws.String("cluster"),
+		Service:           aws.String("app"),
+		TaskDefinitionArn: f.next.TaskDefinitionArn,
+	}
+	if err := cage.EnsureEnvars(envars); err != nil {
+		panic(err)
+	}
+	return envars
+}
+
+func TestSimulator_RollOut(t *testing.T) {
+	for _, v := range []struct{ launchType, networkMode, targetType string }{
+		{"FARGATE", "awsvpc", "ip"},
+		{"EC2", "awsvpc", "ip"},
+		{"EC2", "bridge", "instance"},
+		{"EC2", "host", "instance"},
+	} {
+		f := setup(t, 2, v.launchType, v.networkMode, v.targetType)
+		start := f.sim.Clock.Now()
+		result := f.envars().RollOut(f.sim.Context())
+		if !assert.Nil(t, result.Error, "%+v", v) {
+			continue
+		}
+		assert.True(t, f.sim.Clock.Now().Sub(start) > time.Minute)
+		svc := f.sim.Service("cluster", "app")
+		assert.Equal(t, *f.next.TaskDefinitionArn, *svc.TaskDefinition)
+		assert.Equal(t, 1, len(svc.Deployments))
+		assert.Equal(t, int64(2), *svc.RunningCount)
+		for _, task := range f.sim.ServiceTasks("cluster", "app") {
+			assert.Equal(t, *f.next.TaskDefinitionArn, *task.TaskDefinitionArn)
+		}
+		assert.Equal(t, "INACTIVE", *f.sim.Service("cluster", "app-canary").Status)
+		assert.Equal(t, 0, len(f.sim.ServiceTasks("cluster", "app-canary")))
+	}
+}
+
+func TestSimulator_RollOutUnhealthyCanary(t *testing.T) {
+	f := setup(t, 2, "FARGATE", "awsvpc", "ip")
+	f.sim.SetTaskBehavior(*f.next.TaskDefinitionArn, TaskBehavior{
+		StartDelay:   10 * time.Second,
+		HealthyAfter: 30 * time.Second,
+		Unhealthy:    true,
+	})
+	result := f.envars().RollOut(f.sim.Context())
+	assert.NotNil(t, result.Error)
+	assert.True(t, result.ServiceIntact)
+	svc := f.sim.Service("cluster", "app")
+	assert.Equal(t, *f.current.TaskDefinitionArn, *svc.TaskDefinition)
+	assert.Equal(t, int64(2), *svc.RunningCount)
+}
+
+func TestSimulator_RollOutCrashingTasks(t *testing.T) {
+	f := setup(t, 1, "FARGATE", "awsvpc", "ip")
+	// カナリアタスクは起動直後に落ち続けるのでサービスが安定しない
+	f.sim.SetTaskBehavior(*f.next.TaskDefinitionArn, TaskBehavior{
+		StartDelay: 10 * time.Second,
+		StopAfter:  5 * time.Second,
+		ExitCode:   1,
+	})
+	result := f.envars().RollOut(f.sim.Context())
+	assert.NotNil(t, result.Error)
+	assert.True(t, result.ServiceIntact)
+}
+
+func TestSimulator_InjectError(t *testing.T) {
+	f := setup(t, 1, "FARGATE", "awsvpc", "ip")
+	f.sim.InjectError("CreateService", awserr.New(ecs.ErrCodeAccessDeniedException, "denied", nil), 1)
+	result := f.envars().RollOut(f.sim.Context())
+	if assert.NotNil(t, result.Error) {
+		assert.Equal(t, ecs.ErrCodeAccessDeniedException, result.Error.(awserr.Error).Code())
+	}
+	// 1回だけなので次は成功する
+	result = f.envars().RollOut(f.sim.Context())
+	assert.Nil(t, result.Error)
+}
+
+func TestSimulator_DeploymentConverges(t *testing.T) {
+	f := setup(t, 3, "FARGATE", "awsvpc", "ip")
+	e := f.sim.Ecs()
+	o, err := e.UpdateService(&ecs.UpdateServiceInput{
+		Cluster:        aws.String("cluster"),
+		Service:        aws.String("app"),
+		TaskDefinition: aws.String("app:2"),
+	})
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	assert.Equal(t, 2, len(o.Service.Deployments))
+	f.sim.Clock.Advance(10 * time.Second)
+	svc := f.sim.Service("cluster", "app")
+	assert.Equal(t, int64(3), *svc.RunningCount)
+	assert.Equal(t, int64(3), *svc.PendingCount)
+	// 起動して健康になるまでは古いタスクが残る
+	f.sim.Clock.Advance(30 * time.Second)
+	assert.Equal(t, 2, len(f.sim.Service("cluster", "app").Deployments))
+	f.sim.Clock.Advance(30 * time.Second)
+	svc = f.sim.Service("cluster", "app")
+	assert.Equal(t, 1, len(svc.Deployments))
+	assert.Equal(t, int64(3), *svc.RunningCount)
+	assert.Equal(t, int64(0), *svc.PendingCount)
+	health, err := f.sim.Elbv2().DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{
+		TargetGroupArn: f.targetGroup.TargetGroupArn,
+	})
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	states := map[string]int{}
+	for _, v := range health.TargetHealthDescriptions {
+		states[*v.TargetHealth.State]++
+	}
+	assert.Equal(t, map[string]int{"healthy": 3}, states)
+}
+
+func TestSimulator_DescribeTargetHealthUnused(t *testing.T) {
+	f := setup(t, 1, "FARGATE", "awsvpc", "ip")
+	o, err := f.sim.Elbv2().DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{
+		TargetGroupArn: f.targetGroup.TargetGroupArn,
+		Targets:        []*elbv2.TargetDescription{{Id: aws.String("10.1.1.1"), Port: aws.Int64(80)}},
+	})
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	assert.Equal(t, "unused", *o.TargetHealthDescriptions[0].TargetHealth.State)
+}
+
+func TestSimulator_RunTask(t *testing.T) {
+	sim := NewSimulator()
+	td := registerTaskDefinition(t, sim, "awsvpc")
+	sim.SetTaskBehavior(*td.TaskDefinitionArn, TaskBehavior{
+		StartDelay: 20 * time.Second,
+		StopAfter:  time.Minute,
+		ExitCode:   3,
+	})
+	e := sim.Ecs()
+	o, err := e.RunTask(&ecs.RunTaskInput{
+		Cluster:        aws.String("cluster"),
+		TaskDefinition: aws.String("app"),
+		LaunchType:     aws.String("FARGATE"),
+	})
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	assert.Equal(t, "PENDING", *o.Tasks[0].LastStatus)
+	input := &ecs.DescribeTasksInput{Cluster: aws.String("cluster"), Tasks: []*string{o.Tasks[0].TaskArn}}
+	assert.Nil(t, e.WaitUntilTasksRunning(input))
+	assert.Nil(t, e.WaitUntilTasksStopped(input))
+	d, _ := e.DescribeTasks(input)
+	assert.Equal(t, "STOPPED", *d.Tasks[0].LastStatus)
+	assert.Equal(t, int64(3), *d.Tasks[0].Containers[0].ExitCode)
+	assert.Equal(t, 80*time.Second, d.Tasks[0].StoppedAt.Sub(*d.Tasks[0].CreatedAt))
+}
+
+func TestSimulator_WaiterTimeout(t *testing.T) {
+	sim := NewSimulator()
+	sim.WaiterMaxAttempts = 2
+	td := registerTaskDefinition(t, sim, "awsvpc")
+	e := sim.Ecs()
+	if _, err := e.CreateService(&ecs.CreateServiceInput{
+		ServiceName:    aws.String("app"),
+		TaskDefinition: td.TaskDefinitionArn,
+		DesiredCount:   aws.Int64(1),
+		LaunchType:     aws.String("FARGATE"),
+	}); err != nil {
+		t.Fatalf(err.Error())
+	}
+	err := e.WaitUntilServicesStable(&ecs.DescribeServicesInput{Services: []*string{aws.String("app")}})
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "ResourceNotReady", err.(awserr.Error).Code())
+	}
+	err = e.WaitUntilServicesStable(&ecs.DescribeServicesInput{Services: []*string{aws.String("missing")}})
+	assert.NotNil(t, err)
+}
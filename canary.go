@@ -0,0 +1,114 @@
+package cage
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+)
+
+// 前回のロールアウトが途中で落ちた場合などに残っているカナリアサービスを返す
+// 存在しないかINACTIVEならnil
+func (envars *Envars) DescribeExistingCanaryService(awsEcs ecsiface.ECSAPI) (*ecs.Service, error) {
+	o, err := awsEcs.DescribeServices(&ecs.DescribeServicesInput{
+		Cluster:  envars.Cluster,
+		Services: []*string{envars.CanaryService},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range o.Services {
+		if *s.ServiceName == *envars.CanaryService && *s.Status != "INACTIVE" {
+			return s, nil
+		}
+	}
+	return nil, nil
+}
+
+// --onExistingCanary に従って残っているカナリアサービスを処理する
+// reuse の場合は再利用できるサービスを返すので、後でUpdateCanaryServiceに渡す
+func (envars *Envars) HandleExistingCanaryService(ctx *Context) (*ecs.Service, error) {
+	logger := envars.logger(ctx)
+	existing, err := envars.DescribeExistingCanaryService(ctx.Ecs)
+	if err != nil {
+		logger.WithError(err).Error("failed to describe canary service")
+		return nil, err
+	} else if existing == nil {
+		return nil, nil
+	}
+	policy := OnExistingCanaryAbort
+	if !isEmpty(envars.OnExistingCanary) {
+		policy = *envars.OnExistingCanary
+	}
+	logger = logger.WithField("status", *existing.Status)
+	logger.Warn("canary service already exists")
+	switch policy {
+	case OnExistingCanaryReuse:
+		if *existing.Status == "ACTIVE" {
+			logger.Info("canary service will be reused")
+			return existing, nil
+		}
+		logger.Info("canary service can't be reused. waiting for it to be deleted")
+		return nil, envars.DeleteExistingCanaryService(ctx, existing)
+	case OnExistingCanaryDelete:
+		return nil, envars.DeleteExistingCanaryService(ctx, existing)
+	}
+	return nil, NewErrorf(
+		"canary service '%s' already exists (status: %s). it may be left by previous roll out. "+
+			"delete it manually or specify --onExistingCanary [%s] '%s' or '%s'",
+		*envars.CanaryService, *existing.Status, OnExistingCanaryKey, OnExistingCanaryDelete, OnExistingCanaryReuse,
+	)
+}
+
+func (envars *Envars) DeleteExistingCanaryService(ctx *Context, existing *ecs.Service) error {
+	logger := envars.logger(ctx)
+	awsEcs := ctx.Ecs
+	if *existing.Status == "ACTIVE" {
+		logger.Info("deleting existing canary service...")
+		if _, err := awsEcs.DeleteService(&ecs.DeleteServiceInput{
+			Cluster: envars.Cluster,
+			Service: envars.CanaryService,
+			Force:   aws.Bool(true),
+		}); err != nil {
+			logger.WithError(err).Error("failed to delete existing canary service")
+			return err
+		}
+	}
+	logger.Info("waiting for canary service to become INACTIVE...")
+	if err := awsEcs.WaitUntilServicesInactive(&ecs.DescribeServicesInput{
+		Cluster:  envars.Cluster,
+		Services: []*string{envars.CanaryService},
+	}); err != nil {
+		logger.WithError(err).Error("canary service hasn't reached INACTIVE state within maximum attempt windows")
+		return err
+	}
+	logger.Info("existing canary service has been deleted")
+	return nil
+}
+
+func (envars *Envars) UpdateCanaryService(
+	ctx *Context,
+	nextTaskDefinitionArn *string,
+) error {
+	logger := envars.logger(ctx)
+	awsEcs := ctx.Ecs
+	logger.WithField("desiredCount", 1).Info("updating existing canary service")
+	if _, err := awsEcs.UpdateService(&ecs.UpdateServiceInput{
+		Cluster:        envars.Cluster,
+		Service:        envars.CanaryService,
+		TaskDefinition: nextTaskDefinitionArn,
+		DesiredCount:   aws.Int64(1),
+	}); err != nil {
+		logger.WithError(err).Error("failed to update canary service")
+		return err
+	}
+	logger.Info("waiting for canary service to become STABLE")
+	if err := awsEcs.WaitUntilServicesStable(&ecs.DescribeServicesInput{
+		Cluster:  envars.Cluster,
+		Services: []*string{envars.CanaryService},
+	}); err != nil {
+		logger.WithError(err).Error("canary service hasn't reached STABLE state within maximum attempt windows")
+		return err
+	}
+	logger.Info("canary service has reached STABLE state")
+	return nil
+}
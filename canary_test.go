@@ -0,0 +1,57 @@
+package cage
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/loilo-inc/canarycage/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func setupLeftoverCanary(t *testing.T, policy *string) (*Envars, *test.MockContext, *Context) {
+	envars := DefaultEnvars()
+	envars.OnExistingCanary = policy
+	ctrl := gomock.NewController(t)
+	mocker, ctx := envars.Setup(ctrl, 2, "FARGATE")
+	s, _ := mocker.GetService(*envars.Service)
+	_, _ = mocker.CreateService(&ecs.CreateServiceInput{
+		ServiceName:    envars.CanaryService,
+		TaskDefinition: s.TaskDefinition,
+		DesiredCount:   aws.Int64(1),
+		LaunchType:     aws.String("FARGATE"),
+	})
+	return envars, mocker, ctx
+}
+
+func TestEnvars_RollOut_ExistingCanaryAbort(t *testing.T) {
+	// 既定ではカナリアサービスが残っていたら何もせずに終了する
+	envars, mocker, ctx := setupLeftoverCanary(t, nil)
+	result := envars.RollOut(ctx)
+	assert.NotNil(t, result.Error)
+	assert.True(t, result.ServiceIntact)
+	assert.Equal(t, int64(2), mocker.ServiceSize())
+}
+
+func TestEnvars_RollOut_ExistingCanaryDelete(t *testing.T) {
+	envars, mocker, ctx := setupLeftoverCanary(t, aws.String(OnExistingCanaryDelete))
+	result := envars.RollOut(ctx)
+	assert.Nil(t, result.Error)
+	assert.False(t, result.ServiceIntact)
+	assert.Equal(t, int64(1), mocker.ServiceSize())
+	assert.Equal(t, int64(2), mocker.TaskSize())
+}
+
+func TestEnvars_RollOut_ExistingCanaryReuse(t *testing.T) {
+	envars, mocker, ctx := setupLeftoverCanary(t, aws.String(OnExistingCanaryReuse))
+	existing, err := envars.HandleExistingCanaryService(ctx)
+	assert.Nil(t, err)
+	if assert.NotNil(t, existing) {
+		assert.Equal(t, *envars.CanaryService, *existing.ServiceName)
+	}
+	result := envars.RollOut(ctx)
+	assert.Nil(t, result.Error)
+	assert.False(t, result.ServiceIntact)
+	assert.Equal(t, int64(1), mocker.ServiceSize())
+	assert.Equal(t, int64(2), mocker.TaskSize())
+}
@@ -0,0 +1,298 @@
+package cage
+
+import (
+	"fmt"
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/google/uuid"
+	"strconv"
+	"strings"
+)
+
+const kDefaultCanaryHeaderName = "X-Cage-Canary"
+
+// ALBのリスナーにヘッダーかクッキーで振り分けるルールを一時的に作り、カナリアタスクだけにリクエストを送る
+// カナリアタスクは本番と同じターゲットグループにも登録されるので、ルールの転送先にはカナリア専用のターゲットグループを使う
+// ルールはスモークテストと承認待ちの間だけあり、成功しても失敗しても消す
+type CanaryRouteConfig struct {
+	ListenerArn string `json:"listenerArn"`
+	// カナリア専用のターゲットグループ。ターゲットタイプはサービスのものと揃える
+	// 省略するとサービスのターゲットグループの設定をコピーして一時的に作り、終わったら消す
+	TargetGroupArn string `json:"targetGroupArn,omitempty"`
+	// デフォルトは X-Cage-Canary
+	HeaderName string `json:"headerName,omitempty"`
+	// デフォルトはカナリアサービスの名前
+	HeaderValue string `json:"headerValue,omitempty"`
+	// 例: "canary=1"。指定するとヘッダーの代わりにこのクッキーを持つリクエストを振り分ける
+	Cookie string `json:"cookie,omitempty"`
+	// 省略すると空いている一番小さい優先度
+	Priority int64 `json:"priority,omitempty"`
+}
+
+func validateCanaryRoute(c *CanaryRouteConfig) error {
+	if c == nil {
+		return nil
+	}
+	if c.ListenerArn == "" {
+		return NewErrorf("listenerArn of canaryRoute is required")
+	}
+	if c.Cookie != "" {
+		if c.HeaderName != "" || c.HeaderValue != "" {
+			return NewErrorf("either cookie or headerName/headerValue of canaryRoute can be specified")
+		}
+		if i := strings.Index(c.Cookie, "="); i <= 0 || strings.ContainsAny(c.Cookie, "; ") {
+			return NewErrorf("cookie of canaryRoute must be 'name=value': '%s'", c.Cookie)
+		}
+	}
+	if c.Priority < 0 || c.Priority > 50000 {
+		return NewErrorf("priority of canaryRoute must be between 1 and 50000: %d", c.Priority)
+	}
+	return nil
+}
+
+// カナリアに振り分けられるためにリクエストに付けるヘッダー
+func (envars *Envars) canaryHeader() (string, string) {
+	c := envars.CanaryRoute
+	if c.Cookie != "" {
+		return "Cookie", c.Cookie
+	}
+	name, value := c.HeaderName, c.HeaderValue
+	if name == "" {
+		name = kDefaultCanaryHeaderName
+	}
+	if value == "" {
+		value = *envars.CanaryService
+	}
+	return name, value
+}
+
+// ルールの条件。クッキーはCookieヘッダーのワイルドカードで探す
+func (envars *Envars) canaryRuleCondition() *elbv2.RuleCondition {
+	name, value := envars.canaryHeader()
+	if envars.CanaryRoute.Cookie != "" {
+		value = fmt.Sprintf("*%s*", value)
+	}
+	return &elbv2.RuleCondition{
+		Field: aws.String("http-header"),
+		HttpHeaderConfig: &elbv2.HttpHeaderConditionConfig{
+			HttpHeaderName: aws.String(name),
+			Values:         []*string{aws.String(value)},
+		},
+	}
+}
+
+// 作ったルールと登録したターゲット。Closeで元に戻す
+type CanaryRoute struct {
+	envars *Envars
+	ctx    *Context
+	target *elbv2.TargetDescription
+	// 一時的に作ったターゲットグループならCloseで消す
+	temporary      bool
+	TargetGroupArn *string
+	RuleArn        *string
+	HeaderName     string
+	HeaderValue    string
+}
+
+// 手動で確かめる人向けのカナリアへのアクセス方法
+func (r *CanaryRoute) Description() string {
+	if c := r.envars.CanaryRoute; c.Cookie != "" {
+		return fmt.Sprintf("requests with cookie '%s' to listener '%s' are forwarded to canary task", c.Cookie, c.ListenerArn)
+	}
+	return fmt.Sprintf("requests with header '%s: %s' to listener '%s' are forwarded to canary task", r.HeaderName, r.HeaderValue, r.envars.CanaryRoute.ListenerArn)
+}
+
+// カナリアタスクのターゲットを専用のターゲットグループに登録し、ヘッダーかクッキーで振り分けるルールを作って健康になるのを待つ
+// serviceTargetGroupArnはターゲットグループを一時的に作るときに設定をコピーする元
+func (envars *Envars) OpenCanaryRoute(ctx *Context, serviceTargetGroupArn *string, target *elbv2.TargetDescription) (*CanaryRoute, error) {
+	c := envars.CanaryRoute
+	name, value := envars.canaryHeader()
+	route := &CanaryRoute{envars: envars, ctx: ctx, HeaderName: name, HeaderValue: value}
+	if c.TargetGroupArn != "" {
+		route.TargetGroupArn = aws.String(c.TargetGroupArn)
+	} else {
+		tg, err := envars.createCanaryTargetGroup(ctx, serviceTargetGroupArn)
+		if err != nil {
+			return nil, err
+		}
+		route.TargetGroupArn = tg.TargetGroupArn
+		route.temporary = true
+	}
+	logger := envars.logger(ctx).WithFields(log.Fields{
+		"listener":    c.ListenerArn,
+		"targetGroup": *route.TargetGroupArn,
+		"target":      *target.Id,
+	})
+	logger.Info("registering canary task to canary target group...")
+	if _, err := ctx.Alb.RegisterTargets(&elbv2.RegisterTargetsInput{
+		TargetGroupArn: route.TargetGroupArn,
+		Targets:        []*elbv2.TargetDescription{target},
+	}); err != nil {
+		route.Close()
+		return nil, err
+	}
+	route.target = target
+	priority := c.Priority
+	if priority == 0 {
+		var err error
+		if priority, err = envars.findFreeRulePriority(ctx); err != nil {
+			route.Close()
+			return nil, err
+		}
+	}
+	logger.WithFields(log.Fields{
+		"priority": priority,
+		"header":   name,
+		"value":    value,
+	}).Info("creating listener rule forwarding requests to canary...")
+	o, err := ctx.Alb.CreateRule(&elbv2.CreateRuleInput{
+		ListenerArn: aws.String(c.ListenerArn),
+		Priority:    aws.Int64(priority),
+		Conditions:  []*elbv2.RuleCondition{envars.canaryRuleCondition()},
+		Actions: []*elbv2.Action{{
+			Type:           aws.String(elbv2.ActionTypeEnumForward),
+			TargetGroupArn: route.TargetGroupArn,
+		}},
+	})
+	if err != nil {
+		route.Close()
+		return nil, err
+	}
+	route.RuleArn = o.Rules[0].RuleArn
+	// ルールで使われるまでターゲットグループのヘルスチェックは始まらない
+	if err := envars.waitUntilTargetHealthy(ctx, logger, route.TargetGroupArn, target); err != nil {
+		route.Close()
+		return nil, err
+	}
+	return route, nil
+}
+
+// サービスのターゲットグループと同じプロトコル、ポート、VPC、ヘルスチェックのターゲットグループを作る
+func (envars *Envars) createCanaryTargetGroup(ctx *Context, serviceTargetGroupArn *string) (*elbv2.TargetGroup, error) {
+	if serviceTargetGroupArn == nil {
+		return nil, NewErrorf("targetGroupArn of canaryRoute is required if service has no target group")
+	}
+	tg, err := envars.DescribeTargetGroup(ctx, serviceTargetGroupArn)
+	if err != nil {
+		return nil, err
+	}
+	// ターゲットグループの名前は32文字まで
+	name := fmt.Sprintf("cage-canary-%s", uuid.New().String()[:8])
+	envars.logger(ctx).WithField("targetGroupName", name).Info("creating temporary canary target group...")
+	o, err := ctx.Alb.CreateTargetGroup(&elbv2.CreateTargetGroupInput{
+		Name:                       aws.String(name),
+		Protocol:                   tg.Protocol,
+		Port:                       tg.Port,
+		VpcId:                      tg.VpcId,
+		TargetType:                 tg.TargetType,
+		HealthCheckEnabled:         tg.HealthCheckEnabled,
+		HealthCheckProtocol:        tg.HealthCheckProtocol,
+		HealthCheckPort:            tg.HealthCheckPort,
+		HealthCheckPath:            tg.HealthCheckPath,
+		HealthCheckIntervalSeconds: tg.HealthCheckIntervalSeconds,
+		HealthCheckTimeoutSeconds:  tg.HealthCheckTimeoutSeconds,
+		HealthyThresholdCount:      tg.HealthyThresholdCount,
+		UnhealthyThresholdCount:    tg.UnhealthyThresholdCount,
+		Matcher:                    tg.Matcher,
+	})
+	if err != nil {
+		envars.logger(ctx).WithError(err).Error("failed to create temporary canary target group")
+		return nil, err
+	}
+	return o.TargetGroups[0], nil
+}
+
+// ルールを消してターゲットの登録を解除し、一時的なターゲットグループを消す。失敗はログに出すだけ
+func (r *CanaryRoute) Close() {
+	if r == nil {
+		return
+	}
+	envars, ctx := r.envars, r.ctx
+	logger := envars.logger(ctx)
+	if r.RuleArn != nil {
+		logger.WithField("rule", *r.RuleArn).Info("deleting canary listener rule...")
+		if _, err := ctx.Alb.DeleteRule(&elbv2.DeleteRuleInput{RuleArn: r.RuleArn}); err != nil {
+			logger.WithError(err).Error("failed to delete canary listener rule")
+		} else {
+			r.RuleArn = nil
+		}
+	}
+	if r.temporary {
+		// ターゲットグループを消せばターゲットの登録も消える。ルールが残っていると消せない
+		logger.WithField("targetGroup", *r.TargetGroupArn).Info("deleting temporary canary target group...")
+		if _, err := ctx.Alb.DeleteTargetGroup(&elbv2.DeleteTargetGroupInput{TargetGroupArn: r.TargetGroupArn}); err != nil {
+			logger.WithError(err).Error("failed to delete temporary canary target group")
+		} else {
+			r.temporary = false
+			r.target = nil
+		}
+	} else if r.target != nil {
+		logger.Info("deregistering canary task from canary target group...")
+		if _, err := ctx.Alb.DeregisterTargets(&elbv2.DeregisterTargetsInput{
+			TargetGroupArn: r.TargetGroupArn,
+			Targets:        []*elbv2.TargetDescription{r.target},
+		}); err != nil {
+			logger.WithError(err).Error("failed to deregister canary task")
+		} else {
+			r.target = nil
+		}
+	}
+}
+
+func (envars *Envars) findFreeRulePriority(ctx *Context) (int64, error) {
+	used := make(map[int64]bool)
+	var marker *string
+	for {
+		o, err := ctx.Alb.DescribeRules(&elbv2.DescribeRulesInput{
+			ListenerArn: aws.String(envars.CanaryRoute.ListenerArn),
+			Marker:      marker,
+		})
+		if err != nil {
+			return 0, err
+		}
+		for _, r := range o.Rules {
+			// デフォルトルールの優先度は "default"
+			if p, err := strconv.ParseInt(aws.StringValue(r.Priority), 10, 64); err == nil {
+				used[p] = true
+			}
+		}
+		if marker = o.NextMarker; marker == nil {
+			break
+		}
+	}
+	for p := int64(1); p <= 50000; p++ {
+		if !used[p] {
+			return p, nil
+		}
+	}
+	return 0, NewErrorf("no free rule priority in listener '%s'", envars.CanaryRoute.ListenerArn)
+}
+
+func (envars *Envars) waitUntilTargetHealthy(ctx *Context, logger *log.Entry, tgArn *string, target *elbv2.TargetDescription) error {
+	policy := kAlbHealthPolicy
+	for i := 0; i < policy.maxUnused; i++ {
+		<-ctx.clock().NewTimer(policy.interval).C
+		o, err := ctx.Alb.DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{
+			TargetGroupArn: tgArn,
+			Targets:        []*elbv2.TargetDescription{target},
+		})
+		if err != nil {
+			return err
+		}
+		state := GetTargetIsHealthy(o, target.Id, target.Port)
+		if state == nil {
+			logger.Info("waiting for canary task to be registered...")
+			continue
+		}
+		logger.WithField("state", *state).Info("canary task's health state in canary target group")
+		switch *state {
+		case elbv2.TargetHealthStateEnumHealthy:
+			return nil
+		case elbv2.TargetHealthStateEnumInitial, elbv2.TargetHealthStateEnumUnused:
+			continue
+		}
+		return NewErrorf("canary task '%s' hasn't become healthy in target group '%s'. Recent state: %s", *target.Id, *tgArn, *state)
+	}
+	return NewErrorf("canary task '%s' hasn't become healthy in target group '%s' within maximum attempt windows", *target.Id, *tgArn)
+}
@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/loilo-inc/canarycage"
+	"github.com/urfave/cli"
+)
+
+func ApproveCommand() cli.Command {
+	dest := &cage.Envars{
+		Region:   aws.String(""),
+		Cluster:  aws.String(""),
+		Approval: &cage.ApprovalConfig{},
+	}
+	return cli.Command{
+		Name:        "approve",
+		Description: "approve or reject the roll out of the service waiting for approval",
+		ArgsUsage:   "[service]",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:        "region",
+				EnvVar:      cage.RegionKey,
+				Value:       "us-west-2",
+				Usage:       "aws region for ecs",
+				Destination: dest.Region,
+			},
+			cli.StringFlag{
+				Name:        "cluster",
+				EnvVar:      cage.ClusterKey,
+				Usage:       "ecs cluster name",
+				Destination: dest.Cluster,
+			},
+			cli.StringFlag{
+				Name:        "backend",
+				Value:       cage.ApprovalBackendTag,
+				Usage:       "approval backend used by roll out (tag|file)",
+				Destination: &dest.Approval.Backend,
+			},
+			cli.StringFlag{
+				Name:        "dir",
+				Usage:       "directory of approval markers for file backend",
+				Destination: &dest.Approval.Dir,
+			},
+			cli.BoolFlag{
+				Name:  "reject",
+				Usage: "reject the roll out and abort it",
+			},
+		},
+		Action: func(ctx *cli.Context) {
+			if ctx.NArg() > 0 {
+				dest.Service = aws.String(ctx.Args().Get(0))
+			}
+			if err := Approve(dest, !ctx.Bool("reject")); err != nil {
+				log.Fatalf("failed: %s", err)
+			}
+		},
+	}
+}
+
+func Approve(envars *cage.Envars, approved bool) error {
+	if envars.Cluster == nil || *envars.Cluster == "" || envars.Service == nil || *envars.Service == "" {
+		return cage.NewErrorf("--cluster [%s] and service are required", cage.ClusterKey)
+	}
+	ses, err := session.NewSession(&aws.Config{
+		Region: envars.Region,
+	})
+	if err != nil {
+		return err
+	}
+	marker, err := envars.Approve(&cage.Context{Ecs: ecs.New(ses)}, approved)
+	if err != nil {
+		return err
+	}
+	log.Infof("roll out of '%s' with '%s' %s", *envars.Service, marker.TaskDefinitionArn, marker.State)
+	return nil
+}
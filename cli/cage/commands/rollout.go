@@ -6,21 +6,35 @@ import (
 	"github.com/apex/log"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/loilo-inc/canarycage"
 	"github.com/urfave/cli"
 	"os"
 )
 
-func RollOutCommand() cli.Command {
+func RollOutCommand(tracer *cage.Tracer) cli.Command {
 	dest := &cage.Envars{
-		Region:                  aws.String(""),
-		Cluster:                 aws.String(""),
-		Service:                 aws.String(""),
-		ServiceDefinitionBase64: aws.String(""),
-		TaskDefinitionBase64:    aws.String(""),
-		TaskDefinitionArn:       aws.String(""),
+		Region:                    aws.String(""),
+		Cluster:                   aws.String(""),
+		Service:                   aws.String(""),
+		ServiceDefinitionBase64:   aws.String(""),
+		TaskDefinitionBase64:      aws.String(""),
+		TaskDefinitionArn:         aws.String(""),
+		LockBackend:               aws.String(""),
+		LockTable:                 aws.String(""),
+		OnExistingCanary:          aws.String(""),
+		CodeDeployApplication:     aws.String(""),
+		CodeDeployDeploymentGroup: aws.String(""),
+		CanaryServiceRegistryArn:  aws.String(""),
+		PushgatewayUrl:            aws.String(""),
+		MaxAttempts:               aws.String(""),
 	}
 	return cli.Command{
 		Name:        "rollout",
@@ -35,6 +49,19 @@ func RollOutCommand() cli.Command {
 				Name:  "dryRun",
 				Usage: "describe roll out plan without affecting any resources",
 			},
+			cli.StringFlag{
+				Name:  "manifest",
+				Usage: "manifest json listing deploy contexts of multiple services to roll out in dependency order",
+			},
+			cli.StringFlag{
+				Name:  "regions",
+				Usage: "json listing waves of region/cluster targets to roll out the deploy context one wave after another",
+			},
+			cli.BoolFlag{
+				Name:   "githubActions, github-actions",
+				EnvVar: cage.GithubActionsKey,
+				Usage:  "group logs by phase, annotate failures and write job summary for GitHub Actions",
+			},
 			cli.StringFlag{
 				Name:        "region",
 				EnvVar:      cage.RegionKey,
@@ -55,9 +82,9 @@ func RollOutCommand() cli.Command {
 				Destination: dest.Service,
 			},
 			cli.StringFlag{
-				Name: "canaryService",
-				EnvVar: cage.CanaryServiceKey,
-				Usage: "canary service name",
+				Name:        "canaryService",
+				EnvVar:      cage.CanaryServiceKey,
+				Usage:       "canary service name",
 				Destination: dest.CanaryService,
 			},
 			cli.StringFlag{
@@ -78,6 +105,54 @@ func RollOutCommand() cli.Command {
 				Usage:       "full arn for next task definition",
 				Destination: dest.TaskDefinitionArn,
 			},
+			cli.StringFlag{
+				Name:        "lockBackend",
+				EnvVar:      cage.LockBackendKey,
+				Usage:       "lock backend to prevent concurrent roll outs of the same service (dynamodb|tag). tag is best-effort and not exclusive",
+				Destination: dest.LockBackend,
+			},
+			cli.StringFlag{
+				Name:        "lockTable",
+				EnvVar:      cage.LockTableKey,
+				Usage:       "dynamodb table name for dynamodb lock backend",
+				Destination: dest.LockTable,
+			},
+			cli.StringFlag{
+				Name:        "onExistingCanary, on-existing-canary",
+				EnvVar:      cage.OnExistingCanaryKey,
+				Usage:       "what to do if canary service is left by previous roll out (abort|delete|reuse)",
+				Destination: dest.OnExistingCanary,
+			},
+			cli.StringFlag{
+				Name:        "codeDeployApplication",
+				EnvVar:      cage.CodeDeployApplicationKey,
+				Usage:       "codedeploy application name for services using CODE_DEPLOY deployment controller (default: AppECS-[cluster]-[service])",
+				Destination: dest.CodeDeployApplication,
+			},
+			cli.StringFlag{
+				Name:        "codeDeployDeploymentGroup",
+				EnvVar:      cage.CodeDeployDeploymentGroupKey,
+				Usage:       "codedeploy deployment group name for services using CODE_DEPLOY deployment controller (default: DgpECS-[cluster]-[service])",
+				Destination: dest.CodeDeployDeploymentGroup,
+			},
+			cli.StringFlag{
+				Name:        "canaryServiceRegistryArn",
+				EnvVar:      cage.CanaryServiceRegistryArnKey,
+				Usage:       "cloud map service arn to register canary task instead of the one of current service",
+				Destination: dest.CanaryServiceRegistryArn,
+			},
+			cli.StringFlag{
+				Name:        "pushgatewayUrl",
+				EnvVar:      cage.PushgatewayUrlKey,
+				Usage:       "prometheus pushgateway url to push deploy metrics",
+				Destination: dest.PushgatewayUrl,
+			},
+			cli.StringFlag{
+				Name:        "maxAttempts",
+				EnvVar:      cage.MaxAttemptsKey,
+				Usage:       "max attempts of AWS API calls retried on throttling and transient server errors (default: 5)",
+				Destination: dest.MaxAttempts,
+			},
 		},
 		Action: func(ctx *cli.Context) {
 			if ctx.Bool("skeleton") {
@@ -88,47 +163,194 @@ func RollOutCommand() cli.Command {
 				fmt.Fprint(os.Stdout, string(d))
 				os.Exit(0)
 			}
+			var reporter *cage.GithubActionsReporter
+			if ctx.Bool("githubActions") {
+				reporter = cage.NewGithubActionsReporter()
+			}
+			invalid := func(err error) {
+				reporter.Error("Invalid configuration", err)
+				exitWithError(tracer, cage.ExitCodeInvalidConfig, err)
+			}
+			var manifest *cage.Manifest
+			if ctx.String("manifest") != "" && ctx.String("regions") != "" {
+				invalid(cage.NewErrorf("--manifest and --regions can't be used together"))
+			} else if path := ctx.String("manifest"); path != "" {
+				m, err := cage.LoadManifest(path)
+				if err != nil {
+					invalid(err)
+				}
+				manifest = m
+			} else if path := ctx.String("regions"); path != "" {
+				fanOut, err := cage.LoadFanOut(path)
+				if err != nil {
+					invalid(err)
+				}
+				m, err := fanOut.Manifest(ctx.Args().Get(0))
+				if err != nil {
+					invalid(err)
+				}
+				manifest = m
+			}
+			if manifest != nil {
+				result, err := RollOutManifest(manifest, dest, tracer, reporter)
+				if err != nil {
+					invalid(err)
+				}
+				if err := result.Error(); err != nil {
+					exitWithError(tracer, result.ExitCode(), err)
+				}
+				return
+			}
 			envars := &cage.Envars{}
 			if ctx.NArg() > 0 {
 				// deployコンテクストを指定した場合
 				dir := ctx.Args().Get(0)
 				if err := envars.LoadFromFiles(dir); err != nil {
-					log.Fatalf(err.Error())
+					invalid(err)
 				}
 				if err := envars.Merge(dest); err != nil {
-					log.Fatalf("failed to merge envars from files and cli: %s", err)
+					invalid(cage.NewErrorf("failed to merge envars from files and cli: %s", err))
 				}
 			}
-			ses, err := session.NewSession(&aws.Config{
-				Region: envars.Region,
-			})
+			cageCtx, err := NewContext(envars, tracer, reporter)
 			if err != nil {
 				log.Fatalf("failed to create new AWS session due to: %s", err)
 			}
-			cageCtx := &cage.Context{
-				Ecs: ecs.New(ses),
-				Alb: elbv2.New(ses),
-			}
 			if err := cage.EnsureEnvars(envars); err != nil {
-				log.Fatalf(err.Error())
+				invalid(err)
 			}
-			if err := Action(envars, cageCtx); err != nil {
-				log.Fatalf("failed: %s", err)
+			if result := Action(envars, cageCtx); result.Error != nil {
+				exitWithError(tracer, result.ExitCode(), result.Error)
 			}
 		},
 	}
 }
 
-func Action(envars *cage.Envars, ctx *cage.Context) error {
+// 標準入力は1つなので、マニフェストのすべてのサービスで同じプロンプトを使う
+var ttyApprover = &cage.TtyApprover{In: os.Stdin, Out: os.Stderr}
+
+func NewContext(envars *cage.Envars, tracer *cage.Tracer, reporter *cage.GithubActionsReporter) (*cage.Context, error) {
+	ses, err := session.NewSession(&aws.Config{
+		Region: envars.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// リトライはSDKのセッションでだけ行う
+	ses = envars.RetrySession(ses)
+	// マニフェストのロールアウトは並行するので、スパンの親はコンテクストごとに持つ
+	tracer = tracer.Scope()
+	cage.InstrumentSession(ses, tracer)
+	ret := &cage.Context{
+		Ecs:              ecs.New(ses),
+		Alb:              elbv2.New(ses),
+		Ssm:              ssm.New(ses),
+		Secrets:          secretsmanager.New(ses),
+		Dynamo:           dynamodb.New(ses),
+		CodeDeploy:       codedeploy.New(ses),
+		ServiceDiscovery: servicediscovery.New(ses),
+		Logs:             cloudwatchlogs.New(ses),
+		Tracer:           tracer,
+		GithubActions:    reporter,
+	}
+	// 端末から実行したときだけ承認を求める
+	if stat, err := os.Stdin.Stat(); err == nil && stat.Mode()&os.ModeCharDevice != 0 {
+		ret.Approver = ttyApprover
+	}
+	return ret, nil
+}
+
+// マニフェストの各deployコンテクストにサービスによらないフラグ (region, lockBackendなど) を適用してロールアウトする
+// リージョンごとのクライアントはサービスのregionで作る
+func RollOutManifest(
+	manifest *cage.Manifest,
+	dest *cage.Envars,
+	tracer *cage.Tracer,
+	reporter *cage.GithubActionsReporter,
+) (*cage.ManifestResult, error) {
+	shared := *dest
+	shared.Cluster = nil
+	shared.Service = nil
+	shared.CanaryService = nil
+	shared.ServiceDefinitionBase64 = nil
+	shared.TaskDefinitionBase64 = nil
+	shared.TaskDefinitionArn = nil
+	shared.CodeDeployApplication = nil
+	shared.CodeDeployDeploymentGroup = nil
+	shared.CanaryServiceRegistryArn = nil
+	result, err := cage.RollOutManifest(manifest, func(s *cage.ManifestService) (*cage.Envars, *cage.Context, error) {
+		envars := &cage.Envars{}
+		if err := envars.LoadFromFiles(manifest.ContextDir(s)); err != nil {
+			return nil, nil, err
+		}
+		if err := envars.Merge(&shared); err != nil {
+			return nil, nil, err
+		}
+		if s.Region != "" {
+			envars.Region = aws.String(s.Region)
+		}
+		if s.Cluster != "" {
+			envars.Cluster = aws.String(s.Cluster)
+		}
+		if err := cage.EnsureEnvars(envars); err != nil {
+			return nil, nil, err
+		}
+		// 並行するロールアウトのログがグループにならないので、GitHub Actionsにはまとめて書く
+		ctx, err := NewContext(envars, tracer, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		// 端末のプロンプトは同時に1つしか答えられない
+		if manifest.Parallelism > 1 {
+			ctx.Approver = nil
+		}
+		return envars, ctx, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range result.Services {
+		logger := log.WithField("manifestService", s.Name)
+		switch s.Status {
+		case cage.ManifestServiceSucceeded:
+			logger.Infof("🎉 %s", s.Status)
+		case cage.ManifestServiceFailed:
+			logger.WithError(s.Result.Error).Errorf("😭 %s", s.Status)
+		default:
+			logger.Warnf("⏭ %s because '%s' hasn't been rolled out", s.Status, s.BlockedBy)
+		}
+	}
+	if err := reporter.FinishManifest(result); err != nil {
+		log.WithError(err).Warn("failed to write GitHub Actions job summary")
+	}
+	return result, nil
+}
+
+func Action(envars *cage.Envars, ctx *cage.Context) *cage.RollOutResult {
 	result := envars.RollOut(ctx)
+	logger := envars.Logger()
 	if result.Error != nil {
 		if result.ServiceIntact {
-			log.Errorf("🤕 failed to roll out new tasks but service '%s' is not changed. error: %s", result.Error)
+			logger.WithError(result.Error).Error("🤕 failed to roll out new tasks but service is not changed")
 		} else {
-			log.Errorf("😭 failed to roll out new tasks and service '%s' might be changed. check in console!!. error: %s", result.Error)
+			logger.WithError(result.Error).Error("😭 failed to roll out new tasks and service might be changed. check in console!!")
 		}
-		return result.Error
+		return result
+	}
+	logger.Info("🎉service roll out has completed successfully!🎉")
+	return result
+}
+
+// log.Fatalfは常に1で終了するので、CIで区別できるように終了コードを指定して終了する
+func exitWithError(tracer *cage.Tracer, code int, err error) {
+	ShutdownTracer(tracer)
+	log.Errorf("failed: %s", err)
+	os.Exit(code)
+}
+
+// log.Fatalfで終了する前にも呼んで記録したトレースを送る
+func ShutdownTracer(tracer *cage.Tracer) {
+	if err := tracer.Shutdown(); err != nil {
+		log.Warnf("failed to export traces due to: %s", err)
 	}
-	log.Infof("🎉service roll out has completed successfully!🎉")
-	return nil
 }
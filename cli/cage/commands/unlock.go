@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/loilo-inc/canarycage"
+	"github.com/urfave/cli"
+)
+
+func UnlockCommand() cli.Command {
+	dest := &cage.Envars{
+		Region:      aws.String(""),
+		Cluster:     aws.String(""),
+		Service:     aws.String(""),
+		LockBackend: aws.String(""),
+		LockTable:   aws.String(""),
+	}
+	return cli.Command{
+		Name:        "unlock",
+		Description: "force release a stale deployment lock of the service",
+		ArgsUsage:   "[deploy context path]",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:        "region",
+				EnvVar:      cage.RegionKey,
+				Value:       "us-west-2",
+				Usage:       "aws region for ecs",
+				Destination: dest.Region,
+			},
+			cli.StringFlag{
+				Name:        "cluster",
+				EnvVar:      cage.ClusterKey,
+				Usage:       "ecs cluster name",
+				Destination: dest.Cluster,
+			},
+			cli.StringFlag{
+				Name:        "service",
+				EnvVar:      cage.ServiceKey,
+				Usage:       "service name",
+				Destination: dest.Service,
+			},
+			cli.StringFlag{
+				Name:        "lockBackend",
+				EnvVar:      cage.LockBackendKey,
+				Usage:       "lock backend used by roll out (dynamodb|tag)",
+				Destination: dest.LockBackend,
+			},
+			cli.StringFlag{
+				Name:        "lockTable",
+				EnvVar:      cage.LockTableKey,
+				Usage:       "dynamodb table name for dynamodb lock backend",
+				Destination: dest.LockTable,
+			},
+		},
+		Action: func(ctx *cli.Context) {
+			envars := &cage.Envars{}
+			if ctx.NArg() > 0 {
+				if err := envars.LoadFromFiles(ctx.Args().Get(0)); err != nil {
+					log.Fatalf(err.Error())
+				}
+			}
+			if err := envars.Merge(dest); err != nil {
+				log.Fatalf("failed to merge envars from files and cli: %s", err)
+			}
+			if err := Unlock(envars); err != nil {
+				log.Fatalf("failed: %s", err)
+			}
+		},
+	}
+}
+
+func Unlock(envars *cage.Envars) error {
+	if envars.Cluster == nil || *envars.Cluster == "" || envars.Service == nil || *envars.Service == "" {
+		return cage.NewErrorf("--cluster [%s] and --service [%s] are required", cage.ClusterKey, cage.ServiceKey)
+	}
+	if envars.LockBackend == nil || *envars.LockBackend == "" {
+		return cage.NewErrorf("--lockBackend [%s] is required", cage.LockBackendKey)
+	}
+	ses, err := session.NewSession(&aws.Config{
+		Region: envars.Region,
+	})
+	if err != nil {
+		return err
+	}
+	locker, err := envars.NewLocker(&cage.Context{
+		Ecs:    ecs.New(ses),
+		Dynamo: dynamodb.New(ses),
+	})
+	if err != nil {
+		return err
+	}
+	log.Infof("releasing deployment lock for '%s'...", *envars.Service)
+	if err := locker.ForceUnlock(); err != nil {
+		return err
+	}
+	log.Infof("deployment lock for '%s' released", *envars.Service)
+	return nil
+}
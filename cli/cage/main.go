@@ -1,8 +1,7 @@
 package main
 
 import (
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/loilo-inc/canarycage"
 	"github.com/loilo-inc/canarycage/cli/cage/commands"
 	"github.com/urfave/cli"
 	"log"
@@ -10,20 +9,59 @@ import (
 )
 
 func main() {
-	// cliのdestinationがnil pointerに代入してくれないので無効値を入れておく
-	ses, err := session.NewSession(&aws.Config{
-		Region: aws.String("us-west-2"),
-	})
-	if err != nil {
-		log.Fatalf(err.Error())
-	}
+	tracer := cage.NewTracer()
 	app := cli.NewApp()
 	app.Name = "canarycage"
 	app.Version = "2.1.2"
 	app.Description = "A gradual roll-out deployment tool for AWS ECS"
+	app.Flags = []cli.Flag{
+		cli.StringFlag{
+			Name:   "logFormat, log-format",
+			EnvVar: cage.LogFormatKey,
+			Value:  cage.LogFormatPlain,
+			Usage:  "log output format (plain|text|json)",
+		},
+		cli.StringFlag{
+			Name:   "logLevel, log-level",
+			EnvVar: cage.LogLevelKey,
+			Value:  "info",
+			Usage:  "minimum log level (debug|info|warn|error|fatal)",
+		},
+		cli.StringFlag{
+			Name:   "traceEndpoint",
+			EnvVar: cage.OtlpEndpointKey,
+			Usage:  "OTLP/HTTP endpoint to export traces of roll out. only OTLP/HTTP JSON is supported (not gRPC or protobuf)",
+		},
+		cli.StringFlag{
+			Name:   "traceFile",
+			EnvVar: cage.TraceFileKey,
+			Usage:  "file path to write traces of roll out as OTLP JSON",
+		},
+	}
+	app.Before = func(ctx *cli.Context) error {
+		if err := cage.ConfigureLogger(ctx.String("logFormat"), ctx.String("logLevel"), os.Stderr); err != nil {
+			return err
+		}
+		if v := ctx.String("traceEndpoint"); v != "" {
+			headers := cage.ParseOtlpHeaders(os.Getenv(cage.OtlpHeadersKey))
+			tracer.Exporters = append(tracer.Exporters, cage.NewOtlpHttpExporter(v, headers))
+		}
+		if v := ctx.String("traceFile"); v != "" {
+			tracer.Exporters = append(tracer.Exporters, &cage.JsonFileExporter{Path: v})
+		}
+		return nil
+	}
+	app.After = func(ctx *cli.Context) error {
+		commands.ShutdownTracer(tracer)
+		return nil
+	}
 	app.Commands = cli.Commands{
-		commands.RollOutCommand(),
-		commands.UpCommand(ses),
+		commands.RollOutCommand(tracer),
+		commands.UpCommand(tracer),
+		commands.UnlockCommand(),
+		commands.ApproveCommand(),
+	}
+	if err := app.Run(os.Args); err != nil {
+		log.Fatalf(err.Error())
 	}
-	app.Run(os.Args)
 }
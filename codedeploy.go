@@ -0,0 +1,201 @@
+package cage
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"time"
+)
+
+// CODE_DEPLOY コントローラのサービスはUpdateServiceでタスク定義を変更できないので
+// カナリアの確認後にCodeDeployのBlue/Greenデプロイメントを作成する
+const kCodeDeployPollInterval = time.Duration(15) * time.Second
+const kCodeDeployMaxAttempts = 240
+
+type appSpec struct {
+	Version   string                     `json:"version"`
+	Resources []map[string]appSpecTarget `json:"Resources"`
+}
+
+type appSpecTarget struct {
+	Type       string            `json:"Type"`
+	Properties appSpecProperties `json:"Properties"`
+}
+
+type appSpecProperties struct {
+	TaskDefinition       string                       `json:"TaskDefinition"`
+	LoadBalancerInfo     *appSpecLoadBalancerInfo     `json:"LoadBalancerInfo,omitempty"`
+	PlatformVersion      string                       `json:"PlatformVersion,omitempty"`
+	NetworkConfiguration *appSpecNetworkConfiguration `json:"NetworkConfiguration,omitempty"`
+}
+
+type appSpecLoadBalancerInfo struct {
+	ContainerName string `json:"ContainerName"`
+	ContainerPort int64  `json:"ContainerPort"`
+}
+
+type appSpecNetworkConfiguration struct {
+	AwsvpcConfiguration appSpecAwsvpcConfiguration `json:"AwsvpcConfiguration"`
+}
+
+type appSpecAwsvpcConfiguration struct {
+	Subnets        []string `json:"Subnets"`
+	SecurityGroups []string `json:"SecurityGroups,omitempty"`
+	AssignPublicIp string   `json:"AssignPublicIp,omitempty"`
+}
+
+func IsCodeDeployService(service *ecs.Service) bool {
+	return service.DeploymentController != nil &&
+		aws.StringValue(service.DeploymentController.Type) == ecs.DeploymentControllerTypeCodeDeploy
+}
+
+// 次のタスク定義で現在のサービスを置き換えるAppSpec (JSON) を作る
+func NewAppSpec(service *ecs.Service, nextTaskDefinitionArn string) (string, error) {
+	props := appSpecProperties{
+		TaskDefinition:  nextTaskDefinitionArn,
+		PlatformVersion: aws.StringValue(service.PlatformVersion),
+	}
+	if len(service.LoadBalancers) > 0 {
+		lb := service.LoadBalancers[0]
+		props.LoadBalancerInfo = &appSpecLoadBalancerInfo{
+			ContainerName: aws.StringValue(lb.ContainerName),
+			ContainerPort: aws.Int64Value(lb.ContainerPort),
+		}
+	}
+	if c := service.NetworkConfiguration; c != nil && c.AwsvpcConfiguration != nil {
+		props.NetworkConfiguration = &appSpecNetworkConfiguration{
+			AwsvpcConfiguration: appSpecAwsvpcConfiguration{
+				Subnets:        aws.StringValueSlice(c.AwsvpcConfiguration.Subnets),
+				SecurityGroups: aws.StringValueSlice(c.AwsvpcConfiguration.SecurityGroups),
+				AssignPublicIp: aws.StringValue(c.AwsvpcConfiguration.AssignPublicIp),
+			},
+		}
+	}
+	spec := &appSpec{
+		Version: "0.0",
+		Resources: []map[string]appSpecTarget{{
+			"TargetService": {
+				Type:       "AWS::ECS::Service",
+				Properties: props,
+			},
+		}},
+	}
+	d, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+	return string(d), nil
+}
+
+func (envars *Envars) codeDeployApplication() *string {
+	if !isEmpty(envars.CodeDeployApplication) {
+		return envars.CodeDeployApplication
+	}
+	// ECSコンソールで作成した場合の既定の名前
+	return aws.String(fmt.Sprintf("AppECS-%s-%s", *envars.Cluster, *envars.Service))
+}
+
+func (envars *Envars) codeDeployDeploymentGroup() *string {
+	if !isEmpty(envars.CodeDeployDeploymentGroup) {
+		return envars.CodeDeployDeploymentGroup
+	}
+	return aws.String(fmt.Sprintf("DgpECS-%s-%s", *envars.Cluster, *envars.Service))
+}
+
+// CodeDeployのデプロイメントを作成して完了まで待つ
+// 失敗した場合はロールバックされたかどうかを返す
+func (envars *Envars) DeployWithCodeDeploy(
+	ctx *Context,
+	service *ecs.Service,
+	nextTaskDefinitionArn *string,
+) (bool, error) {
+	logger := envars.logger(ctx)
+	if ctx.CodeDeploy == nil {
+		return false, NewErrorf("codedeploy client is not configured")
+	}
+	content, err := NewAppSpec(service, *nextTaskDefinitionArn)
+	if err != nil {
+		logger.WithError(err).Error("failed to generate appspec")
+		return false, err
+	}
+	sum := sha256.Sum256([]byte(content))
+	logger = logger.WithFields(log.Fields{
+		"application":     *envars.codeDeployApplication(),
+		"deploymentGroup": *envars.codeDeployDeploymentGroup(),
+	})
+	logger.Info("creating deployment...")
+	logger.WithField("appspec", content).Debug("appspec generated")
+	o, err := ctx.CodeDeploy.CreateDeployment(&codedeploy.CreateDeploymentInput{
+		ApplicationName:     envars.codeDeployApplication(),
+		DeploymentGroupName: envars.codeDeployDeploymentGroup(),
+		Description:         aws.String(fmt.Sprintf("canarycage: roll out '%s' to '%s'", *envars.Service, *nextTaskDefinitionArn)),
+		Revision: &codedeploy.RevisionLocation{
+			RevisionType: aws.String(codedeploy.RevisionLocationTypeAppSpecContent),
+			AppSpecContent: &codedeploy.AppSpecContent{
+				Content: aws.String(content),
+				Sha256:  aws.String(hex.EncodeToString(sum[:])),
+			},
+		},
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to create deployment")
+		return false, err
+	}
+	deploymentId := o.DeploymentId
+	logger = logger.WithField("deployment", *deploymentId)
+	logger.Info("deployment created")
+	var recentStatus string
+	for i := 0; i < kCodeDeployMaxAttempts; i++ {
+		<-ctx.clock().NewTimer(kCodeDeployPollInterval).C
+		o, err := ctx.CodeDeploy.GetDeployment(&codedeploy.GetDeploymentInput{
+			DeploymentId: deploymentId,
+		})
+		if err != nil {
+			logger.WithError(err).Error("failed to get deployment")
+			return envars.stopCodeDeployDeployment(ctx, deploymentId, err)
+		}
+		info := o.DeploymentInfo
+		status := aws.StringValue(info.Status)
+		if status != recentStatus {
+			logger.WithField("status", status).Info("deployment status changed")
+			recentStatus = status
+		}
+		switch status {
+		case codedeploy.DeploymentStatusSucceeded:
+			return false, nil
+		case codedeploy.DeploymentStatusFailed, codedeploy.DeploymentStatusStopped:
+			msg := status
+			if info.ErrorInformation != nil {
+				msg = fmt.Sprintf("%s: %s", aws.StringValue(info.ErrorInformation.Code), aws.StringValue(info.ErrorInformation.Message))
+			}
+			rolledBack := info.RollbackInfo != nil && info.RollbackInfo.RollbackDeploymentId != nil
+			if rolledBack {
+				logger.WithField("rollbackDeployment", *info.RollbackInfo.RollbackDeploymentId).Warn("deployment has been rolled back")
+			}
+			return rolledBack, NewErrorf("deployment '%s' hasn't succeeded (%s)", *deploymentId, msg)
+		}
+	}
+	return envars.stopCodeDeployDeployment(
+		ctx, deploymentId,
+		NewErrorf("deployment '%s' hasn't finished within maximum attempt windows. recent status: %s", *deploymentId, recentStatus),
+	)
+}
+
+func (envars *Envars) stopCodeDeployDeployment(ctx *Context, deploymentId *string, cause error) (bool, error) {
+	logger := envars.logger(ctx).WithField("deployment", *deploymentId)
+	logger.Warn("stopping deployment with rollback...")
+	if _, err := ctx.CodeDeploy.StopDeployment(&codedeploy.StopDeploymentInput{
+		DeploymentId:        deploymentId,
+		AutoRollbackEnabled: aws.Bool(true),
+	}); err != nil {
+		logger.WithError(err).Error("failed to stop deployment")
+		return false, cause
+	}
+	logger.Info("deployment has been stopped and rolled back")
+	return true, cause
+}
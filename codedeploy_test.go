@@ -0,0 +1,99 @@
+package cage
+
+import (
+	"encoding/json"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/loilo-inc/canarycage/mock/mock_codedeploy"
+	"github.com/loilo-inc/canarycage/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func setupCodeDeploy(t *testing.T, statuses ...*codedeploy.DeploymentInfo) (*Envars, *test.MockContext, *Context) {
+	envars := DefaultEnvars()
+	ctrl := gomock.NewController(t)
+	mocker, ctx := envars.Setup(ctrl, 2, "FARGATE")
+	s, _ := mocker.GetService(*envars.Service)
+	s.DeploymentController = &ecs.DeploymentController{
+		Type: aws.String(ecs.DeploymentControllerTypeCodeDeploy),
+	}
+	cdMock := mock_codedeploy.NewMockCodeDeployAPI(ctrl)
+	cdMock.EXPECT().CreateDeployment(gomock.Any()).DoAndReturn(func(input *codedeploy.CreateDeploymentInput) (*codedeploy.CreateDeploymentOutput, error) {
+		assert.Equal(t, "AppECS-cage-test-service", *input.ApplicationName)
+		assert.Equal(t, "DgpECS-cage-test-service", *input.DeploymentGroupName)
+		assert.Equal(t, codedeploy.RevisionLocationTypeAppSpecContent, *input.Revision.RevisionType)
+		return &codedeploy.CreateDeploymentOutput{DeploymentId: aws.String("d-123")}, nil
+	})
+	var calls []*gomock.Call
+	for _, v := range statuses {
+		calls = append(calls, cdMock.EXPECT().GetDeployment(gomock.Any()).Return(&codedeploy.GetDeploymentOutput{
+			DeploymentInfo: v,
+		}, nil))
+	}
+	gomock.InOrder(calls...)
+	ctx.CodeDeploy = cdMock
+	return envars, mocker, ctx
+}
+
+func TestEnvars_RollOut_CodeDeploy(t *testing.T) {
+	envars, mocker, ctx := setupCodeDeploy(t,
+		&codedeploy.DeploymentInfo{Status: aws.String(codedeploy.DeploymentStatusInProgress)},
+		&codedeploy.DeploymentInfo{Status: aws.String(codedeploy.DeploymentStatusSucceeded)},
+	)
+	s, _ := mocker.GetService(*envars.Service)
+	current := *s.TaskDefinition
+	result := envars.RollOut(ctx)
+	assert.Nil(t, result.Error)
+	assert.False(t, result.ServiceIntact)
+	assert.False(t, result.RolledBack)
+	// UpdateServiceではなくCodeDeployでタスク定義を切り替える
+	assert.Equal(t, current, *s.TaskDefinition)
+	assert.Equal(t, int64(1), mocker.ServiceSize())
+}
+
+func TestEnvars_RollOut_CodeDeployFailed(t *testing.T) {
+	envars, _, ctx := setupCodeDeploy(t,
+		&codedeploy.DeploymentInfo{
+			Status: aws.String(codedeploy.DeploymentStatusFailed),
+			ErrorInformation: &codedeploy.ErrorInformation{
+				Code:    aws.String("HEALTH_CONSTRAINTS"),
+				Message: aws.String("unhealthy"),
+			},
+			RollbackInfo: &codedeploy.RollbackInfo{
+				RollbackDeploymentId: aws.String("d-456"),
+			},
+		},
+	)
+	result := envars.RollOut(ctx)
+	assert.NotNil(t, result.Error)
+	assert.False(t, result.ServiceIntact)
+	assert.True(t, result.RolledBack)
+}
+
+func TestNewAppSpec(t *testing.T) {
+	content, err := NewAppSpec(&ecs.Service{
+		PlatformVersion: aws.String("1.3.0"),
+		LoadBalancers: []*ecs.LoadBalancer{{
+			ContainerName: aws.String("container"),
+			ContainerPort: aws.Int64(80),
+		}},
+		NetworkConfiguration: &ecs.NetworkConfiguration{
+			AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+				Subnets:        []*string{aws.String("subnet-1")},
+				SecurityGroups: []*string{aws.String("sg-1")},
+				AssignPublicIp: aws.String("DISABLED"),
+			},
+		},
+	}, "arn://next")
+	assert.Nil(t, err)
+	var spec map[string]interface{}
+	assert.Nil(t, json.Unmarshal([]byte(content), &spec))
+	props := spec["Resources"].([]interface{})[0].(map[string]interface{})["TargetService"].(map[string]interface{})["Properties"].(map[string]interface{})
+	assert.Equal(t, "arn://next", props["TaskDefinition"])
+	assert.Equal(t, "container", props["LoadBalancerInfo"].(map[string]interface{})["ContainerName"])
+	assert.Equal(t, float64(80), props["LoadBalancerInfo"].(map[string]interface{})["ContainerPort"])
+	assert.Equal(t, "1.3.0", props["PlatformVersion"])
+}
@@ -0,0 +1,381 @@
+package cage
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/aws/aws-sdk-go/service/codedeploy/codedeployiface"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/aws/aws-sdk-go/service/servicediscovery/servicediscoveryiface"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"sync"
+)
+
+// ライブラリとしてcageを組み込むためのインターフェース
+// 時計、ロガー、AWSクライアントはDeployerごとに持つので、1つのプロセスで複数のロールアウトを並行して動かせる
+type Deployer interface {
+	RollOut() *RollOutResult
+	// サービスを新規作成して安定するまで待つ
+	Up() (*ecs.Service, error)
+	Status() (*DeployStatus, error)
+	// 直前のRollOutの前のタスク定義にサービスを戻し、残っているカナリアサービスを消す
+	Rollback() error
+}
+
+// ロールアウトの途中で呼ばれるコールバック
+type Hooks struct {
+	// 各フェーズに入ったとき
+	OnPhase func(phase RollOutPhase)
+	// ロールアウトが終わったとき。失敗しても呼ばれる
+	OnResult func(result *RollOutResult)
+}
+
+func (h *Hooks) phase(phase RollOutPhase) {
+	if h != nil && h.OnPhase != nil {
+		h.OnPhase(phase)
+	}
+}
+
+func (h *Hooks) result(result *RollOutResult) {
+	if h != nil && h.OnResult != nil {
+		h.OnResult(result)
+	}
+}
+
+type DeployStatus struct {
+	Service *ecs.Service
+	// 残っているカナリアサービス。なければnil
+	CanaryService *ecs.Service
+	// プライマリのデプロイメントのタスク定義
+	TaskDefinitionArn *string
+	// デプロイメントが1つで、desiredCountのタスクが動いている
+	Stable bool
+}
+
+type Option func(d *deployer)
+
+func WithEnvars(envars *Envars) Option {
+	return func(d *deployer) {
+		d.envars = envars
+	}
+}
+
+// セッションからAWSクライアントを作る。With* で個別に指定したクライアントが優先される
+// セッションのリトライは Envars.RetrySession で cage のものに置き換える
+func WithSession(ses *session.Session) Option {
+	return func(d *deployer) {
+		d.ses = ses
+	}
+}
+
+func WithEcs(api ecsiface.ECSAPI) Option {
+	return func(d *deployer) {
+		d.ctx.Ecs = api
+	}
+}
+
+func WithAlb(api elbv2iface.ELBV2API) Option {
+	return func(d *deployer) {
+		d.ctx.Alb = api
+	}
+}
+
+func WithSsm(api ssmiface.SSMAPI) Option {
+	return func(d *deployer) {
+		d.ctx.Ssm = api
+	}
+}
+
+func WithSecretsManager(api secretsmanageriface.SecretsManagerAPI) Option {
+	return func(d *deployer) {
+		d.ctx.Secrets = api
+	}
+}
+
+func WithDynamoDB(api dynamodbiface.DynamoDBAPI) Option {
+	return func(d *deployer) {
+		d.ctx.Dynamo = api
+	}
+}
+
+func WithCodeDeploy(api codedeployiface.CodeDeployAPI) Option {
+	return func(d *deployer) {
+		d.ctx.CodeDeploy = api
+	}
+}
+
+func WithServiceDiscovery(api servicediscoveryiface.ServiceDiscoveryAPI) Option {
+	return func(d *deployer) {
+		d.ctx.ServiceDiscovery = api
+	}
+}
+
+func WithCloudWatchLogs(api cloudwatchlogsiface.CloudWatchLogsAPI) Option {
+	return func(d *deployer) {
+		d.ctx.Logs = api
+	}
+}
+
+func WithClock(clock Clock) Option {
+	return func(d *deployer) {
+		d.ctx.Clock = clock
+	}
+}
+
+func WithLogger(logger log.Interface) Option {
+	return func(d *deployer) {
+		d.ctx.Logger = logger
+	}
+}
+
+func WithHooks(hooks *Hooks) Option {
+	return func(d *deployer) {
+		d.ctx.Hooks = hooks
+	}
+}
+
+func WithTracer(tracer *Tracer) Option {
+	return func(d *deployer) {
+		d.ctx.Tracer = tracer
+	}
+}
+
+func WithApprover(approver Approver) Option {
+	return func(d *deployer) {
+		d.ctx.Approver = approver
+	}
+}
+
+type deployer struct {
+	envars *Envars
+	ctx    *Context
+	ses    *session.Session
+	// 直前のRollOutの結果
+	last *RollOutResult
+	mux  sync.Mutex
+}
+
+func New(opts ...Option) (Deployer, error) {
+	d := &deployer{ctx: &Context{}}
+	for _, opt := range opts {
+		opt(d)
+	}
+	if d.envars == nil {
+		return nil, NewErrorf("envars is required")
+	}
+	// EnsureEnvarsで書き換えるので呼び出し元のEnvarsとは共有しない
+	envars := *d.envars
+	if err := EnsureEnvars(&envars); err != nil {
+		return nil, err
+	}
+	d.envars = &envars
+	if d.ses != nil {
+		d.newClients(d.envars.RetrySession(d.ses))
+	}
+	if d.ctx.Ecs == nil || d.ctx.Alb == nil {
+		return nil, NewErrorf("ecs and elbv2 clients are required. use WithSession or WithEcs and WithAlb")
+	}
+	// スパンの時刻もロールアウトと同じ時計で測る
+	if d.ctx.Clock != nil && d.ctx.Tracer != nil && d.ctx.Tracer.Clock == nil {
+		d.ctx.Tracer = d.ctx.Tracer.Scope()
+		d.ctx.Tracer.Clock = d.ctx.Clock
+	}
+	return d, nil
+}
+
+func (d *deployer) newClients(ses *session.Session) {
+	if d.ctx.Ecs == nil {
+		d.ctx.Ecs = ecs.New(ses)
+	}
+	if d.ctx.Alb == nil {
+		d.ctx.Alb = elbv2.New(ses)
+	}
+	if d.ctx.Ssm == nil {
+		d.ctx.Ssm = ssm.New(ses)
+	}
+	if d.ctx.Secrets == nil {
+		d.ctx.Secrets = secretsmanager.New(ses)
+	}
+	if d.ctx.Dynamo == nil {
+		d.ctx.Dynamo = dynamodb.New(ses)
+	}
+	if d.ctx.CodeDeploy == nil {
+		d.ctx.CodeDeploy = codedeploy.New(ses)
+	}
+	if d.ctx.ServiceDiscovery == nil {
+		d.ctx.ServiceDiscovery = servicediscovery.New(ses)
+	}
+	if d.ctx.Logs == nil {
+		d.ctx.Logs = cloudwatchlogs.New(ses)
+	}
+}
+
+// 同じDeployerでのロールアウトは同時に1つだけ
+func (d *deployer) RollOut() *RollOutResult {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	result := d.envars.RollOut(d.ctx)
+	d.last = result
+	return result
+}
+
+func (d *deployer) Up() (*ecs.Service, error) {
+	return d.envars.Up(d.ctx)
+}
+
+func (d *deployer) Status() (*DeployStatus, error) {
+	return d.envars.Status(d.ctx)
+}
+
+func (d *deployer) Rollback() error {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	if d.last == nil || d.last.PreviousTaskDefinitionArn == nil {
+		return NewErrorf("no roll out to roll back")
+	}
+	return d.envars.Rollback(d.ctx, d.last.PreviousTaskDefinitionArn)
+}
+
+// サービス定義とタスク定義からサービスを作成して安定するまで待つ
+func (envars *Envars) Up(ctx *Context) (*ecs.Service, error) {
+	span := ctx.Tracer.Start("up")
+	span.SetAttribute("ecs.cluster", *envars.Cluster)
+	span.SetAttribute("ecs.service", *envars.Service)
+	svc, err := envars.up(ctx)
+	span.SetError(err)
+	span.End()
+	return svc, err
+}
+
+func (envars *Envars) up(ctx *Context) (*ecs.Service, error) {
+	logger := envars.logger(ctx)
+	if isEmpty(envars.ServiceDefinitionBase64) {
+		return nil, NewErrorf("service definition is required to create service")
+	}
+	input := &ecs.CreateServiceInput{}
+	if data, err := base64.StdEncoding.DecodeString(*envars.ServiceDefinitionBase64); err != nil {
+		return nil, NewErrorf("failed to decode service definition base64: %s", err)
+	} else if err := json.Unmarshal(data, input); err != nil {
+		return nil, NewErrorf("failed to unmarshal ecs.CreateServiceInput: %s", err)
+	}
+	logger.Info("registering task definition...")
+	td, err := envars.CreateNextTaskDefinition(ctx.Ecs)
+	if err != nil {
+		return nil, err
+	}
+	logger = logger.WithField("taskDefinition", *td.TaskDefinitionArn)
+	input.Cluster = envars.Cluster
+	input.ServiceName = envars.Service
+	input.TaskDefinition = td.TaskDefinitionArn
+	logger.Info("creating service...")
+	if _, err := ctx.Ecs.CreateService(input); err != nil {
+		return nil, NewErrorf("failed to create service '%s': %s", *envars.Service, err)
+	}
+	logger.Info("waiting for service to be STABLE")
+	if err := ctx.Ecs.WaitUntilServicesStable(&ecs.DescribeServicesInput{
+		Cluster:  envars.Cluster,
+		Services: []*string{envars.Service},
+	}); err != nil {
+		return nil, err
+	}
+	logger.Info("service has become STABLE")
+	status, err := envars.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return status.Service, nil
+}
+
+func (envars *Envars) Status(ctx *Context) (*DeployStatus, error) {
+	o, err := ctx.Ecs.DescribeServices(&ecs.DescribeServicesInput{
+		Cluster:  envars.Cluster,
+		Services: []*string{envars.Service},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var service *ecs.Service
+	for _, v := range o.Services {
+		if *v.ServiceName == *envars.Service && aws.StringValue(v.Status) != "INACTIVE" {
+			service = v
+		}
+	}
+	if service == nil {
+		return nil, NewErrorf("service '%s' not found in cluster '%s'", *envars.Service, *envars.Cluster)
+	}
+	canary, err := envars.DescribeExistingCanaryService(ctx.Ecs)
+	if err != nil {
+		return nil, err
+	}
+	ret := &DeployStatus{
+		Service:           service,
+		CanaryService:     canary,
+		TaskDefinitionArn: service.TaskDefinition,
+		Stable: len(service.Deployments) <= 1 &&
+			aws.Int64Value(service.RunningCount) == aws.Int64Value(service.DesiredCount),
+	}
+	for _, v := range service.Deployments {
+		if aws.StringValue(v.Status) == "PRIMARY" {
+			ret.TaskDefinitionArn = v.TaskDefinition
+		}
+	}
+	return ret, nil
+}
+
+// 残っているカナリアサービスを消して、サービスをtaskDefinitionArnに戻す
+// UpdateServiceでタスク定義を戻せるのはECSデプロイメントコントローラのサービスだけ
+func (envars *Envars) Rollback(ctx *Context, taskDefinitionArn *string) error {
+	logger := envars.logger(ctx).WithField("taskDefinition", *taskDefinitionArn)
+	status, err := envars.Status(ctx)
+	if err != nil {
+		return err
+	}
+	if IsCodeDeployService(status.Service) || IsExternalService(status.Service) {
+		return NewErrorf(
+			"cannot roll back service '%s' with %s deployment controller. roll out the previous task definition '%s' instead",
+			*envars.Service, *status.Service.DeploymentController.Type, *taskDefinitionArn,
+		)
+	}
+	if canary, err := envars.DescribeExistingCanaryService(ctx.Ecs); err != nil {
+		return err
+	} else if canary != nil {
+		if err := envars.DeleteExistingCanaryService(ctx, canary); err != nil {
+			return err
+		}
+	}
+	if aws.StringValue(status.TaskDefinitionArn) == *taskDefinitionArn {
+		logger.Info("service is already running with the task definition")
+		return nil
+	}
+	logger.Warn("rolling back task definition of service...")
+	if _, err := ctx.Ecs.UpdateService(&ecs.UpdateServiceInput{
+		Cluster:        envars.Cluster,
+		Service:        envars.Service,
+		TaskDefinition: taskDefinitionArn,
+	}); err != nil {
+		return err
+	}
+	logger.Info("waiting for service to be stable...")
+	if err := ctx.Ecs.WaitUntilServicesStable(&ecs.DescribeServicesInput{
+		Cluster:  envars.Cluster,
+		Services: []*string{envars.Service},
+	}); err != nil {
+		return err
+	}
+	logger.Info("service has been rolled back")
+	return nil
+}
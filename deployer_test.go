@@ -0,0 +1,153 @@
+package cage
+
+import (
+	"encoding/base64"
+	"github.com/apex/log"
+	"github.com/apex/log/handlers/memory"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/golang/mock/gomock"
+	"github.com/loilo-inc/canarycage/mock/mock_ecs"
+	"github.com/loilo-inc/canarycage/mock/mock_elbv2"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"sync"
+	"testing"
+	"time"
+)
+
+// タイマーは待たずに時計を進めてすぐ発火する
+type testClock struct {
+	now time.Time
+	mux sync.Mutex
+}
+
+func (c *testClock) Now() time.Time {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return c.now
+}
+
+func (c *testClock) NewTimer(d time.Duration) *time.Timer {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.now = c.now.Add(d)
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return &time.Timer{C: ch}
+}
+
+func TestNew(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ecsMock := mock_ecs.NewMockECSAPI(ctrl)
+	albMock := mock_elbv2.NewMockELBV2API(ctrl)
+	_, err := New(WithEcs(ecsMock), WithAlb(albMock))
+	assert.NotNil(t, err)
+	_, err = New(WithEnvars(DefaultEnvars()))
+	assert.NotNil(t, err)
+	_, err = New(WithEnvars(&Envars{Cluster: aws.String("cluster")}), WithEcs(ecsMock), WithAlb(albMock))
+	assert.NotNil(t, err)
+	// 呼び出し元のEnvarsは書き換えない
+	envars := DefaultEnvars()
+	envars.CanaryService = nil
+	d, err := New(WithEnvars(envars), WithEcs(ecsMock), WithAlb(albMock))
+	assert.Nil(t, err)
+	assert.NotNil(t, d)
+	assert.Nil(t, envars.CanaryService)
+}
+
+func TestDeployer_RollOut(t *testing.T) {
+	envars := DefaultEnvars()
+	ctrl := gomock.NewController(t)
+	mocker, ctx := envars.Setup(ctrl, 2, "FARGATE")
+	service, _ := mocker.GetService(*envars.Service)
+	previous := *service.TaskDefinition
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := &testClock{now: start}
+	handler := memory.New()
+	var phases []RollOutPhase
+	var hookResult *RollOutResult
+	d, err := New(
+		WithEnvars(envars),
+		WithEcs(ctx.Ecs),
+		WithAlb(ctx.Alb),
+		WithClock(clock),
+		WithLogger(&log.Logger{Handler: handler, Level: log.InfoLevel}),
+		WithHooks(&Hooks{
+			OnPhase: func(phase RollOutPhase) {
+				phases = append(phases, phase)
+			},
+			OnResult: func(result *RollOutResult) {
+				hookResult = result
+			},
+		}),
+	)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	result := d.RollOut()
+	if result.Error != nil {
+		t.Fatalf(result.Error.Error())
+	}
+	assert.Equal(t, result, hookResult)
+	assert.Equal(t, []RollOutPhase{PhaseStarted, PhaseCanaryHealthy, PhasePrimaryUpdating}, phases)
+	// 待ち時間はDeployerの時計で進む
+	assert.Equal(t, start, result.StartTime)
+	assert.True(t, result.EndTime.Sub(start) >= 10*time.Second)
+	assert.Equal(t, previous, *result.PreviousTaskDefinitionArn)
+	// ログはDeployerのロガーに出る
+	assert.NotEmpty(t, handler.Entries)
+	for _, e := range handler.Entries {
+		assert.Equal(t, "service", e.Fields["service"])
+	}
+	status, err := d.Status()
+	if assert.Nil(t, err) {
+		assert.Equal(t, *result.TaskDefinitionArn, *status.TaskDefinitionArn)
+		assert.Nil(t, status.CanaryService)
+	}
+	assert.Nil(t, d.Rollback())
+	status, err = d.Status()
+	if assert.Nil(t, err) {
+		assert.Equal(t, previous, *status.TaskDefinitionArn)
+	}
+}
+
+func TestDeployer_RollOutConcurrently(t *testing.T) {
+	var wg sync.WaitGroup
+	for _, v := range []string{"service-a", "service-b", "service-c"} {
+		envars := DefaultEnvars()
+		envars.Service = aws.String(v)
+		envars.CanaryService = aws.String(v + "-canary")
+		_, ctx := envars.Setup(gomock.NewController(t), 2, "FARGATE")
+		d, err := New(WithEnvars(envars), WithEcs(ctx.Ecs), WithAlb(ctx.Alb), WithClock(&testClock{now: time.Now()}))
+		if err != nil {
+			t.Fatalf(err.Error())
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Nil(t, d.RollOut().Error)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestDeployer_Up(t *testing.T) {
+	envars := DefaultEnvars()
+	svc, _ := ioutil.ReadFile("fixtures/service.json")
+	envars.ServiceDefinitionBase64 = aws.String(base64.StdEncoding.EncodeToString(svc))
+	envars.Service = aws.String("service-up")
+	ctrl := gomock.NewController(t)
+	mocker, ctx := envars.Setup(ctrl, 1, "FARGATE")
+	d, err := New(WithEnvars(envars), WithEcs(ctx.Ecs), WithAlb(ctx.Alb), WithClock(&testClock{now: time.Now()}))
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	o, err := d.Up()
+	if assert.Nil(t, err) {
+		assert.Equal(t, "service-up", *o.ServiceName)
+		_, ok := mocker.GetService("service-up")
+		assert.True(t, ok)
+	}
+	// ロールアウトしていなければ戻せない
+	assert.NotNil(t, d.Rollback())
+}
@@ -11,14 +11,28 @@ import (
 )
 
 type Envars struct {
-	_                       struct{} `type:"struct"`
-	Region                  *string  `json:"region" type:"string"`
-	Cluster                 *string  `json:"cluster" type:"string" required:"true"`
-	Service                 *string  `json:"service" type:"string" required:"true"`
-	CanaryService           *string
-	TaskDefinitionBase64    *string `json:"nextTaskDefinitionBase64" type:"string"`
-	TaskDefinitionArn       *string `json:"nextTaskDefinitionArn" type:"string"`
-	ServiceDefinitionBase64 *string
+	_                         struct{} `type:"struct"`
+	Region                    *string  `json:"region" type:"string"`
+	Cluster                   *string  `json:"cluster" type:"string" required:"true"`
+	Service                   *string  `json:"service" type:"string" required:"true"`
+	CanaryService             *string
+	TaskDefinitionBase64      *string `json:"nextTaskDefinitionBase64" type:"string"`
+	TaskDefinitionArn         *string `json:"nextTaskDefinitionArn" type:"string"`
+	ServiceDefinitionBase64   *string
+	LockBackend               *string            `json:"lockBackend" type:"string"`
+	LockTable                 *string            `json:"lockTable" type:"string"`
+	OnExistingCanary          *string            `json:"onExistingCanary" type:"string"`
+	CodeDeployApplication     *string            `json:"codeDeployApplication" type:"string"`
+	CodeDeployDeploymentGroup *string            `json:"codeDeployDeploymentGroup" type:"string"`
+	CanaryServiceRegistryArn  *string            `json:"canaryServiceRegistryArn" type:"string"`
+	Notifiers                 []*NotifierConfig  `json:"notifiers,omitempty"`
+	PushgatewayUrl            *string            `json:"pushgatewayUrl" type:"string"`
+	MaxAttempts               *string            `json:"maxAttempts" type:"string"`
+	LifecycleHooks            []*LifecycleHook   `json:"hooks,omitempty"`
+	Migration                 *MigrationConfig   `json:"migrate,omitempty"`
+	CanaryRoute               *CanaryRouteConfig `json:"canaryRoute,omitempty"`
+	SmokeTests                *SmokeTestConfig   `json:"smokeTests,omitempty"`
+	Approval                  *ApprovalConfig    `json:"approval,omitempty"`
 }
 
 // required
@@ -34,6 +48,18 @@ const kDefaultRegion = "us-west-2"
 // optional
 const CanaryServiceKey = "CAGE_CANARY_SERVICE"
 const RegionKey = "CAGE_REGION"
+const LockBackendKey = "CAGE_LOCK_BACKEND"
+const LockTableKey = "CAGE_LOCK_TABLE"
+const OnExistingCanaryKey = "CAGE_ON_EXISTING_CANARY"
+const CodeDeployApplicationKey = "CAGE_CODE_DEPLOY_APPLICATION"
+const CodeDeployDeploymentGroupKey = "CAGE_CODE_DEPLOY_DEPLOYMENT_GROUP"
+const CanaryServiceRegistryArnKey = "CAGE_CANARY_SERVICE_REGISTRY_ARN"
+const PushgatewayUrlKey = "CAGE_PUSHGATEWAY_URL"
+
+// policies for a canary service left by previous roll out
+const OnExistingCanaryAbort = "abort"
+const OnExistingCanaryDelete = "delete"
+const OnExistingCanaryReuse = "reuse"
 
 func isEmpty(o *string) bool {
 	return o == nil || *o == ""
@@ -51,6 +77,38 @@ func EnsureEnvars(
 	if isEmpty(dest.TaskDefinitionArn) && isEmpty(dest.TaskDefinitionBase64) {
 		return NewErrorf("--nextTaskDefinitionArn or --nextTaskDefinitionBase64 must be provided")
 	}
+	if !isEmpty(dest.LockBackend) && *dest.LockBackend != LockBackendDynamoDB && *dest.LockBackend != LockBackendTag {
+		return NewErrorf("--lockBackend [%s] must be '%s' or '%s'", LockBackendKey, LockBackendDynamoDB, LockBackendTag)
+	}
+	if isEmpty(dest.OnExistingCanary) {
+		dest.OnExistingCanary = aws.String(OnExistingCanaryAbort)
+	}
+	switch *dest.OnExistingCanary {
+	case OnExistingCanaryAbort, OnExistingCanaryDelete, OnExistingCanaryReuse:
+	default:
+		return NewErrorf(
+			"--onExistingCanary [%s] must be one of '%s', '%s' or '%s'",
+			OnExistingCanaryKey, OnExistingCanaryAbort, OnExistingCanaryDelete, OnExistingCanaryReuse,
+		)
+	}
+	if _, err := parseMaxAttempts(dest.MaxAttempts); err != nil {
+		return err
+	}
+	if err := validateLifecycleHooks(dest.LifecycleHooks); err != nil {
+		return err
+	}
+	if err := validateMigration(dest.Migration); err != nil {
+		return err
+	}
+	if err := validateCanaryRoute(dest.CanaryRoute); err != nil {
+		return err
+	}
+	if err := validateSmokeTests(dest.SmokeTests, dest.CanaryRoute); err != nil {
+		return err
+	}
+	if err := validateApproval(dest.Approval); err != nil {
+		return err
+	}
 	if isEmpty(dest.Region) {
 		dest.Region = aws.String(kDefaultRegion)
 	}
@@ -84,6 +142,13 @@ func (e *Envars) LoadFromFiles(dir string) error {
 	} else {
 		tdBase64 = base64.StdEncoding.EncodeToString(d)
 	}
+	// deployコンテクストごとの設定 (通知先など) はオプション
+	cfgPath := filepath.Join(dir, "cage.json")
+	if _, err := os.Stat(cfgPath); err == nil {
+		if _, err := ReadAndUnmarshalJson(cfgPath, e); err != nil {
+			return NewErrorf("failed to read and unmarshal cage.json: %s", err)
+		}
+	}
 	e.Cluster = svc.Cluster
 	e.Service = svc.ServiceName
 	e.ServiceDefinitionBase64 = &svcBase64
@@ -113,6 +178,30 @@ func (e *Envars) Merge(o *Envars) error {
 	if !isEmpty(o.ServiceDefinitionBase64) {
 		e.ServiceDefinitionBase64 = o.ServiceDefinitionBase64
 	}
+	if !isEmpty(o.LockBackend) {
+		e.LockBackend = o.LockBackend
+	}
+	if !isEmpty(o.LockTable) {
+		e.LockTable = o.LockTable
+	}
+	if !isEmpty(o.OnExistingCanary) {
+		e.OnExistingCanary = o.OnExistingCanary
+	}
+	if !isEmpty(o.CodeDeployApplication) {
+		e.CodeDeployApplication = o.CodeDeployApplication
+	}
+	if !isEmpty(o.CodeDeployDeploymentGroup) {
+		e.CodeDeployDeploymentGroup = o.CodeDeployDeploymentGroup
+	}
+	if !isEmpty(o.CanaryServiceRegistryArn) {
+		e.CanaryServiceRegistryArn = o.CanaryServiceRegistryArn
+	}
+	if !isEmpty(o.PushgatewayUrl) {
+		e.PushgatewayUrl = o.PushgatewayUrl
+	}
+	if !isEmpty(o.MaxAttempts) {
+		e.MaxAttempts = o.MaxAttempts
+	}
 	return nil
 }
 
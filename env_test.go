@@ -3,6 +3,9 @@ package cage
 import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -85,4 +88,37 @@ func TestEnvars_Merge(t *testing.T) {
 	assert.Equal(t, *e1.Cluster, "hoge")
 	assert.Equal(t, *e1.Service, "fuga")
 	assert.Equal(t, *e1.CanaryService, "canary")
-}
\ No newline at end of file
+}
+func TestEnsureEnvars_OnExistingCanary(t *testing.T) {
+	e := dummyEnvs()
+	assert.Nil(t, EnsureEnvars(e))
+	assert.Equal(t, OnExistingCanaryAbort, *e.OnExistingCanary)
+	e = dummyEnvs()
+	e.OnExistingCanary = aws.String("overwrite")
+	assert.NotNil(t, EnsureEnvars(e))
+}
+
+func TestEnvars_LoadFromFiles_CageJson(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "cage")
+	defer os.RemoveAll(dir)
+	for _, v := range []string{"service.json", "task-definition.json"} {
+		d, _ := ioutil.ReadFile(filepath.Join("fixtures", v))
+		_ = ioutil.WriteFile(filepath.Join(dir, v), d, 0644)
+	}
+	// cage.json はオプション
+	e := &Envars{}
+	assert.Nil(t, e.LoadFromFiles(dir))
+	assert.Nil(t, e.Notifiers)
+	os.Setenv("CAGE_TEST_SLACK_URL", "https://hooks.slack.com/services/xxx")
+	defer os.Unsetenv("CAGE_TEST_SLACK_URL")
+	_ = ioutil.WriteFile(filepath.Join(dir, "cage.json"), []byte(`{
+  "onExistingCanary": "delete",
+  "notifiers": [{"type": "slack", "url": "${CAGE_TEST_SLACK_URL}", "channel": "#deploy"}]
+}`), 0644)
+	e = &Envars{}
+	assert.Nil(t, e.LoadFromFiles(dir))
+	assert.Equal(t, "cluster", *e.Cluster)
+	assert.Equal(t, "delete", *e.OnExistingCanary)
+	assert.Equal(t, "https://hooks.slack.com/services/xxx", e.Notifiers[0].Url)
+	assert.Equal(t, "#deploy", e.Notifiers[0].Channel)
+}
@@ -0,0 +1,102 @@
+package cage
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+// 同じサービスを複数のリージョン・クラスタにウェーブごとにロールアウトする
+// ウェーブ内のターゲットは同時にロールアウトし、どれかが失敗したら残りのウェーブはスキップする
+type FanOut struct {
+	Waves [][]*FanOutTarget `json:"waves"`
+	// contextの相対パスの基準。LoadFanOutではファイルのディレクトリ
+	Dir string `json:"-"`
+}
+
+type FanOutTarget struct {
+	Region string `json:"region"`
+	// 省略したらdeployコンテクストのクラスタ
+	Cluster string `json:"cluster,omitempty"`
+	// リージョンによってサブネットなどが違う場合のdeployコンテクスト。省略したらrolloutに渡したもの
+	Context string `json:"context,omitempty"`
+}
+
+// ログと結果に使う名前
+func (t *FanOutTarget) Name() string {
+	if t.Cluster == "" {
+		return t.Region
+	}
+	return fmt.Sprintf("%s/%s", t.Region, t.Cluster)
+}
+
+func LoadFanOut(path string) (*FanOut, error) {
+	ret := &FanOut{}
+	if _, err := ReadAndUnmarshalJson(path, ret); err != nil {
+		return nil, NewErrorf("failed to read and unmarshal regions '%s': %s", path, err)
+	}
+	ret.Dir = filepath.Dir(path)
+	if err := ret.Validate(); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (f *FanOut) Validate() error {
+	if len(f.Waves) == 0 {
+		return NewErrorf("waves of regions are required")
+	}
+	names := make(map[string]bool)
+	for i, wave := range f.Waves {
+		if len(wave) == 0 {
+			return NewErrorf("waves[%d] has no targets", i)
+		}
+		for j, t := range wave {
+			if t.Region == "" {
+				return NewErrorf("region of waves[%d][%d] is required", i, j)
+			}
+			if names[t.Name()] {
+				return NewErrorf("target '%s' is duplicated in regions", t.Name())
+			}
+			names[t.Name()] = true
+		}
+	}
+	return nil
+}
+
+// 各ウェーブが前のウェーブのすべてのターゲットに依存するマニフェストにする
+// context は contextを省略したターゲットのdeployコンテクスト
+func (f *FanOut) Manifest(context string) (*Manifest, error) {
+	ret := &Manifest{}
+	var prev []string
+	for i, wave := range f.Waves {
+		var names []string
+		for j, t := range wave {
+			dir := context
+			if t.Context != "" {
+				dir = t.Context
+				if !filepath.IsAbs(dir) {
+					dir = filepath.Join(f.Dir, dir)
+				}
+			}
+			if dir == "" {
+				return nil, NewErrorf("context of waves[%d][%d] is required unless deploy context is given", i, j)
+			}
+			ret.Services = append(ret.Services, &ManifestService{
+				Name:      t.Name(),
+				Context:   dir,
+				DependsOn: prev,
+				Region:    t.Region,
+				Cluster:   t.Cluster,
+			})
+			names = append(names, t.Name())
+		}
+		if len(wave) > ret.Parallelism {
+			ret.Parallelism = len(wave)
+		}
+		prev = names
+	}
+	if err := ret.Validate(); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,110 @@
+package cage
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadFanOut(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "cage-fanout-test")
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "regions.json")
+	_ = ioutil.WriteFile(path, []byte(`{
+  "waves": [
+    [{"region": "us-west-2", "cluster": "prod"}],
+    [{"region": "ap-northeast-1"}, {"region": "eu-west-1", "cluster": "prod-eu", "context": "./eu"}]
+  ]
+}`), 0644)
+	f, err := LoadFanOut(path)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	assert.Equal(t, 2, len(f.Waves))
+	assert.Equal(t, "us-west-2/prod", f.Waves[0][0].Name())
+	assert.Equal(t, "ap-northeast-1", f.Waves[1][0].Name())
+	m, err := f.Manifest("./deploy")
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	assert.Equal(t, 2, m.Parallelism)
+	assert.Equal(t, &ManifestService{Name: "us-west-2/prod", Context: "./deploy", Region: "us-west-2", Cluster: "prod"}, m.Services[0])
+	assert.Equal(t, &ManifestService{
+		Name: "ap-northeast-1", Context: "./deploy", DependsOn: []string{"us-west-2/prod"}, Region: "ap-northeast-1",
+	}, m.Services[1])
+	assert.Equal(t, filepath.Join(dir, "eu"), m.ContextDir(m.Services[2]))
+	assert.Equal(t, []string{"us-west-2/prod"}, m.Services[2].DependsOn)
+	// deployコンテクストがなければcontextを省略できない
+	_, err = f.Manifest("")
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "context of waves[0][0] is required unless deploy context is given", err.Error())
+	}
+}
+
+func TestFanOut_Validate(t *testing.T) {
+	for _, v := range []struct {
+		waves [][]*FanOutTarget
+		err   string
+	}{
+		{nil, "waves of regions are required"},
+		{[][]*FanOutTarget{{{Region: "us-west-2"}}, {}}, "waves[1] has no targets"},
+		{[][]*FanOutTarget{{{Region: "us-west-2"}, {Cluster: "prod"}}}, "region of waves[0][1] is required"},
+		{[][]*FanOutTarget{{{Region: "us-west-2"}}, {{Region: "us-west-2"}}}, "target 'us-west-2' is duplicated in regions"},
+	} {
+		err := (&FanOut{Waves: v.waves}).Validate()
+		if assert.NotNil(t, err) {
+			assert.Equal(t, v.err, err.Error())
+		}
+	}
+	assert.Nil(t, (&FanOut{Waves: [][]*FanOutTarget{
+		{{Region: "us-west-2"}, {Region: "us-west-2", Cluster: "prod"}},
+	}}).Validate())
+}
+
+func TestRollOutManifest_FanOut(t *testing.T) {
+	f := &FanOut{Waves: [][]*FanOutTarget{
+		{{Region: "us-west-2"}},
+		{{Region: "ap-northeast-1"}, {Region: "eu-west-1"}},
+		{{Region: "us-east-1"}},
+	}}
+	m, err := f.Manifest("./deploy")
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	ctrl := gomock.NewController(t)
+	// リージョンごとにクライアントを作る
+	regions := make(map[string]*string)
+	result, err := RollOutManifest(m, func(s *ManifestService) (*Envars, *Context, error) {
+		envars := DefaultEnvars()
+		envars.Region = aws.String(s.Region)
+		_, ctx := envars.Setup(ctrl, 1, "FARGATE")
+		if s.Region == "eu-west-1" {
+			// このリージョンにはタスク定義がない
+			envars.TaskDefinitionArn = aws.String("arn://unknown")
+		}
+		regions[s.Name] = envars.Region
+		return envars, ctx, nil
+	})
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	assert.Equal(t, 4, len(regions))
+	statuses := make(map[string]*ManifestServiceResult)
+	for _, s := range result.Services {
+		statuses[s.Name] = s
+	}
+	assert.Equal(t, ManifestServiceSucceeded, statuses["us-west-2"].Status)
+	assert.Equal(t, "us-west-2", *statuses["us-west-2"].Envars.Region)
+	// 同じウェーブの他のリージョンは最後まで進める
+	assert.Equal(t, ManifestServiceSucceeded, statuses["ap-northeast-1"].Status)
+	assert.Equal(t, ManifestServiceFailed, statuses["eu-west-1"].Status)
+	// 残りのウェーブは止める
+	assert.Equal(t, ManifestServiceSkipped, statuses["us-east-1"].Status)
+	assert.Equal(t, "eu-west-1", statuses["us-east-1"].BlockedBy)
+	assert.Nil(t, statuses["us-east-1"].Result)
+	assert.NotNil(t, result.Error())
+}
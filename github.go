@@ -0,0 +1,208 @@
+package cage
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 終了コード。log.Fatalfで終了する予期しないエラーは1
+const (
+	ExitCodeOk             = 0
+	ExitCodeError          = 1
+	ExitCodeInvalidConfig  = 2
+	ExitCodeServiceIntact  = 3
+	ExitCodeRolledBack     = 4
+	ExitCodeServiceChanged = 5
+)
+
+// ロールアウトの結果をCIで区別できる終了コードにする
+func (r *RollOutResult) ExitCode() int {
+	if r.Error == nil {
+		return ExitCodeOk
+	} else if r.RolledBack {
+		return ExitCodeRolledBack
+	} else if r.ServiceIntact {
+		return ExitCodeServiceIntact
+	}
+	return ExitCodeServiceChanged
+}
+
+const GithubActionsKey = "CAGE_GITHUB_ACTIONS"
+const GithubStepSummaryKey = "GITHUB_STEP_SUMMARY"
+
+// GitHub Actionsのワークフローコマンドでフェーズごとにログをグループにまとめ、
+// 失敗をアノテーションとして出し、結果をジョブのサマリーに書く
+// nilのGithubActionsReporterは何もしない
+type GithubActionsReporter struct {
+	Out         io.Writer
+	SummaryPath string
+	grouped     bool
+	mux         sync.Mutex
+}
+
+func NewGithubActionsReporter() *GithubActionsReporter {
+	return &GithubActionsReporter{
+		Out:         os.Stdout,
+		SummaryPath: os.Getenv(GithubStepSummaryKey),
+	}
+}
+
+// ワークフローコマンドのメッセージでは % と改行をエスケープする
+func escapeWorkflowCommand(str string) string {
+	str = strings.Replace(str, "%", "%25", -1)
+	str = strings.Replace(str, "\r", "%0D", -1)
+	return strings.Replace(str, "\n", "%0A", -1)
+}
+
+func (g *GithubActionsReporter) annotate(title string, message string) {
+	fmt.Fprintf(g.Out, "::error title=%s::%s\n", title, escapeWorkflowCommand(message))
+}
+
+// ロールアウトを始める前の設定の誤りなどをアノテーションにする
+func (g *GithubActionsReporter) Error(title string, err error) {
+	if g == nil {
+		return
+	}
+	g.mux.Lock()
+	defer g.mux.Unlock()
+	g.annotate(title, err.Error())
+}
+
+func (g *GithubActionsReporter) EnterPhase(phase RollOutPhase) {
+	if g == nil {
+		return
+	}
+	g.mux.Lock()
+	defer g.mux.Unlock()
+	if g.grouped {
+		fmt.Fprintln(g.Out, "::endgroup::")
+	}
+	fmt.Fprintf(g.Out, "::group::%s\n", phase)
+	g.grouped = true
+}
+
+func (g *GithubActionsReporter) Finish(envars *Envars, result *RollOutResult) error {
+	if g == nil {
+		return nil
+	}
+	g.mux.Lock()
+	defer g.mux.Unlock()
+	if g.grouped {
+		fmt.Fprintln(g.Out, "::endgroup::")
+		g.grouped = false
+	}
+	switch result.ExitCode() {
+	case ExitCodeOk:
+	case ExitCodeServiceIntact:
+		g.annotate("Roll out failed", fmt.Sprintf(
+			"roll out of '%s' has failed but the service is not changed: %s", *envars.Service, result.Error,
+		))
+	case ExitCodeRolledBack:
+		g.annotate("Roll out rolled back", fmt.Sprintf(
+			"roll out of '%s' has failed and been rolled back: %s", *envars.Service, result.Error,
+		))
+	default:
+		g.annotate("Roll out failed", fmt.Sprintf(
+			"roll out of '%s' has failed and the service might be changed. check in console: %s", *envars.Service, result.Error,
+		))
+	}
+	if g.SummaryPath == "" {
+		return nil
+	}
+	f, err := os.OpenFile(g.SummaryPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.WriteString(f, RollOutSummary(envars, result))
+	return err
+}
+
+// マニフェストの失敗したサービスをアノテーションにし、まとめた結果をサマリーに書く
+func (g *GithubActionsReporter) FinishManifest(result *ManifestResult) error {
+	if g == nil {
+		return nil
+	}
+	g.mux.Lock()
+	defer g.mux.Unlock()
+	for _, s := range result.Services {
+		if s.Status == ManifestServiceFailed {
+			g.annotate("Roll out failed", fmt.Sprintf("roll out of '%s' has failed: %s", s.Name, s.Result.Error))
+		}
+	}
+	if g.SummaryPath == "" {
+		return nil
+	}
+	f, err := os.OpenFile(g.SummaryPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.WriteString(f, ManifestSummary(result))
+	return err
+}
+
+// ジョブのサマリーに書くMarkdown
+func RollOutSummary(envars *Envars, result *RollOutResult) string {
+	var b strings.Builder
+	switch result.ExitCode() {
+	case ExitCodeOk:
+		fmt.Fprintf(&b, "### :tada: `%s` has been rolled out\n\n", *envars.Service)
+	case ExitCodeServiceIntact:
+		fmt.Fprintf(&b, "### :x: roll out of `%s` has failed but the service is not changed\n\n", *envars.Service)
+	case ExitCodeRolledBack:
+		fmt.Fprintf(&b, "### :rewind: roll out of `%s` has failed and been rolled back\n\n", *envars.Service)
+	default:
+		fmt.Fprintf(&b, "### :fire: roll out of `%s` has failed and the service might be changed\n\n", *envars.Service)
+	}
+	b.WriteString("| | |\n|---|---|\n")
+	fmt.Fprintf(&b, "| Cluster | `%s` |\n", *envars.Cluster)
+	fmt.Fprintf(&b, "| Service | `%s` |\n", *envars.Service)
+	if result.TaskDefinitionArn != nil {
+		fmt.Fprintf(&b, "| Task definition | `%s` |\n", *result.TaskDefinitionArn)
+	}
+	fmt.Fprintf(&b, "| Outcome | %s |\n", DeployOutcome(result.Error, result.RolledBack))
+	fmt.Fprintf(&b, "| Duration | %s |\n", result.EndTime.Sub(result.StartTime).Truncate(time.Second))
+	fmt.Fprintf(&b, "| Exit code | %d |\n", result.ExitCode())
+	if result.Error != nil {
+		fmt.Fprintf(&b, "| Error | %s |\n", strings.Replace(result.Error.Error(), "|", "\\|", -1))
+	}
+	durations := result.PhaseDurations()
+	if len(durations) > 0 {
+		b.WriteString("\n| Phase | Duration |\n|---|---|\n")
+		for _, phase := range kPhaseOrder {
+			if d, ok := durations[phase]; ok {
+				fmt.Fprintf(&b, "| %s | %s |\n", phase, d.Truncate(time.Second))
+			}
+		}
+	}
+	if result.CanaryHealthWait > 0 {
+		fmt.Fprintf(&b, "\nCanary task became healthy in %s.\n", result.CanaryHealthWait.Truncate(time.Second))
+	}
+	if result.Approval != "" {
+		fmt.Fprintf(&b, "\nPaused for approval for %s (%s).\n", result.ApprovalWait.Truncate(time.Second), result.Approval)
+	}
+	if len(result.SmokeChecks) > 0 {
+		b.WriteString("\n| Smoke check | Status | Latency | Result |\n|---|---|---|---|\n")
+		for _, c := range result.SmokeChecks {
+			outcome := ":white_check_mark:"
+			if c.Error != "" {
+				outcome = ":x: " + strings.Replace(c.Error, "|", "\\|", -1)
+			}
+			fmt.Fprintf(&b, "| %s | %d | %s | %s |\n", c.Name, c.StatusCode, c.Latency.Truncate(time.Millisecond), outcome)
+		}
+	}
+	b.WriteString("\n")
+	return b.String()
+}
+
+// サマリーに書けなくてもロールアウトの結果には影響させない
+func (g *GithubActionsReporter) finish(envars *Envars, result *RollOutResult) {
+	if err := g.Finish(envars, result); err != nil {
+		envars.Logger().WithError(err).Warn("failed to write GitHub Actions job summary")
+	}
+}
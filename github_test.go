@@ -0,0 +1,109 @@
+package cage
+
+import (
+	"bytes"
+	"errors"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRollOutResult_ExitCode(t *testing.T) {
+	err := errors.New("err")
+	assert.Equal(t, ExitCodeOk, (&RollOutResult{ServiceIntact: false}).ExitCode())
+	assert.Equal(t, ExitCodeServiceIntact, (&RollOutResult{ServiceIntact: true, Error: err}).ExitCode())
+	assert.Equal(t, ExitCodeRolledBack, (&RollOutResult{RolledBack: true, Error: err}).ExitCode())
+	assert.Equal(t, ExitCodeServiceChanged, (&RollOutResult{Error: err}).ExitCode())
+}
+
+func newSummaryFile(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "cage-summary")
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	return filepath.Join(dir, "summary.md"), func() { os.RemoveAll(dir) }
+}
+
+func TestEnvars_RollOut_GithubActions(t *testing.T) {
+	path, cleanup := newSummaryFile(t)
+	defer cleanup()
+	var out bytes.Buffer
+	envars := DefaultEnvars()
+	_, ctx := envars.Setup(gomock.NewController(t), 2, "FARGATE")
+	ctx.GithubActions = &GithubActionsReporter{Out: &out, SummaryPath: path}
+	result := envars.RollOut(ctx)
+	assert.Nil(t, result.Error)
+	assert.Equal(t, ExitCodeOk, result.ExitCode())
+	assert.Equal(t, strings.Join([]string{
+		"::group::started",
+		"::endgroup::",
+		"::group::canary_healthy",
+		"::endgroup::",
+		"::group::primary_updating",
+		"::endgroup::",
+		"",
+	}, "\n"), out.String())
+	d, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	summary := string(d)
+	assert.Contains(t, summary, ":tada: `service` has been rolled out")
+	assert.Contains(t, summary, "| Task definition | `"+*result.TaskDefinitionArn+"` |")
+	assert.Contains(t, summary, "| primary_updating |")
+}
+
+func TestEnvars_RollOut_GithubActionsFailed(t *testing.T) {
+	var out bytes.Buffer
+	envars := DefaultEnvars()
+	_, ctx := envars.Setup(gomock.NewController(t), 2, "FARGATE")
+	envars.TaskDefinitionArn = aws.String("arn://unknown")
+	ctx.GithubActions = &GithubActionsReporter{Out: &out}
+	result := envars.RollOut(ctx)
+	assert.Equal(t, ExitCodeServiceIntact, result.ExitCode())
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Equal(t, "::group::started", lines[0])
+	assert.Equal(t, "::endgroup::", lines[1])
+	assert.True(t, strings.HasPrefix(lines[2], "::error title=Roll out failed::roll out of 'service' has failed but the service is not changed"), lines[2])
+}
+
+func TestRollOutSummary(t *testing.T) {
+	start := time.Unix(1000, 0)
+	result := &RollOutResult{
+		StartTime:  start,
+		EndTime:    start.Add(90 * time.Second),
+		RolledBack: true,
+		Error:      errors.New("deployment failed | stopped"),
+		PhaseTimes: map[RollOutPhase]time.Time{
+			PhaseStarted:         start,
+			PhaseCanaryHealthy:   start.Add(60 * time.Second),
+			PhasePrimaryUpdating: start.Add(60 * time.Second),
+		},
+		CanaryHealthWait: 45 * time.Second,
+	}
+	summary := RollOutSummary(DefaultEnvars(), result)
+	assert.Contains(t, summary, ":rewind: roll out of `service` has failed and been rolled back")
+	assert.Contains(t, summary, "| Duration | 1m30s |")
+	assert.Contains(t, summary, "| Exit code | 4 |")
+	assert.Contains(t, summary, "| Error | deployment failed \\| stopped |")
+	assert.Contains(t, summary, "| started | 1m0s |")
+	assert.Contains(t, summary, "| primary_updating | 30s |")
+	assert.Contains(t, summary, "Canary task became healthy in 45s.")
+	assert.NotContains(t, summary, "Task definition")
+}
+
+func TestGithubActionsReporter_Error(t *testing.T) {
+	var out bytes.Buffer
+	g := &GithubActionsReporter{Out: &out}
+	g.Error("Invalid configuration", errors.New("100% wrong\nconfig"))
+	assert.Equal(t, "::error title=Invalid configuration::100%25 wrong%0Aconfig\n", out.String())
+	var nilReporter *GithubActionsReporter
+	nilReporter.EnterPhase(PhaseStarted)
+	assert.Nil(t, nilReporter.Finish(DefaultEnvars(), &RollOutResult{}))
+}
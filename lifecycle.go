@@ -0,0 +1,231 @@
+package cage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+type LifecycleHookEvent string
+
+const (
+	// カナリアを作る前
+	HookPreCanary LifecycleHookEvent = "pre-canary"
+	// カナリアタスクが健康になった後
+	HookPostCanaryHealthy LifecycleHookEvent = "post-canary-healthy"
+	// プライマリのタスク定義を更新する直前
+	HookPrePrimaryUpdate LifecycleHookEvent = "pre-primary-update"
+	// ロールアウトが成功した後
+	HookPostRollout LifecycleHookEvent = "post-rollout"
+	// ロールアウトが失敗した後。このフックの失敗はログに出すだけ
+	HookOnFailure LifecycleHookEvent = "on-failure"
+)
+
+const kDefaultHookTimeout = time.Duration(30) * time.Minute
+const kHookStartedBy = "cage-hook"
+
+// フックに渡す環境変数
+const HookEventKey = "CAGE_HOOK_EVENT"
+const HookNameKey = "CAGE_HOOK_NAME"
+const HookTaskDefinitionArnKey = "CAGE_NEXT_TASK_DEFINITION_ARN"
+const HookPreviousTaskDefinitionArnKey = "CAGE_PREVIOUS_TASK_DEFINITION_ARN"
+const HookErrorKey = "CAGE_ERROR"
+
+// deployコンテクストの cage.json の "hooks" に書く
+// command はcageを動かしているマシンで実行し、runTask は次のタスク定義でコマンドを上書きしたタスクをサービスと同じネットワークで1回だけ動かす
+type LifecycleHook struct {
+	Name    string             `json:"name"`
+	On      LifecycleHookEvent `json:"on"`
+	Command []string           `json:"command,omitempty"`
+	RunTask *RunTaskHook       `json:"runTask,omitempty"`
+	// 例: "10m"。デフォルトは30分
+	Timeout string `json:"timeout,omitempty"`
+}
+
+type RunTaskHook struct {
+	// コマンドを上書きするコンテナ。省略すると最初のessentialなコンテナ
+	Container string   `json:"container,omitempty"`
+	Command   []string `json:"command,omitempty"`
+}
+
+func (h *LifecycleHook) String() string {
+	return fmt.Sprintf("%s hook '%s'", h.On, h.Name)
+}
+
+func (h *LifecycleHook) timeout() time.Duration {
+	if d, err := time.ParseDuration(h.Timeout); err == nil && h.Timeout != "" {
+		return d
+	}
+	return kDefaultHookTimeout
+}
+
+func validateLifecycleHooks(hooks []*LifecycleHook) error {
+	for i, h := range hooks {
+		if h.Name == "" {
+			return NewErrorf("name of hooks[%d] is required", i)
+		}
+		switch h.On {
+		case HookPreCanary, HookPostCanaryHealthy, HookPrePrimaryUpdate, HookPostRollout, HookOnFailure:
+		default:
+			return NewErrorf(
+				"'on' of hook '%s' must be one of '%s', '%s', '%s', '%s' or '%s' but got '%s'",
+				h.Name, HookPreCanary, HookPostCanaryHealthy, HookPrePrimaryUpdate, HookPostRollout, HookOnFailure, h.On,
+			)
+		}
+		if (len(h.Command) > 0) == (h.RunTask != nil) {
+			return NewErrorf("either 'command' or 'runTask' of hook '%s' must be specified", h.Name)
+		}
+		if h.Timeout != "" {
+			if d, err := time.ParseDuration(h.Timeout); err != nil || d <= 0 {
+				return NewErrorf("timeout of hook '%s' must be a positive duration: '%s'", h.Name, h.Timeout)
+			}
+		}
+	}
+	return nil
+}
+
+// ロールアウト中にフックを実行する
+// runTaskのフックは次のタスク定義が登録されるまで実行できない
+type lifecycleHookRunner struct {
+	envars             *Envars
+	ctx                *Context
+	service            *ecs.Service
+	nextTaskDefinition *ecs.TaskDefinition
+}
+
+func (envars *Envars) newLifecycleHookRunner(ctx *Context) *lifecycleHookRunner {
+	return &lifecycleHookRunner{envars: envars, ctx: ctx}
+}
+
+// eventのフックを書かれた順に実行し、最初に失敗したところで止める
+func (r *lifecycleHookRunner) Run(event LifecycleHookEvent) error {
+	return r.run(event, nil)
+}
+
+// 失敗したロールアウトのエラーを渡してon-failureのフックを実行する。フックの失敗は無視する
+func (r *lifecycleHookRunner) RunOnFailure(cause error) {
+	if err := r.run(HookOnFailure, cause); err != nil {
+		r.envars.logger(r.ctx).WithError(err).Warn("on-failure hook failed")
+	}
+}
+
+func (r *lifecycleHookRunner) run(event LifecycleHookEvent, cause error) error {
+	for _, h := range r.envars.LifecycleHooks {
+		if h.On != event {
+			continue
+		}
+		logger := r.envars.logger(r.ctx).WithFields(log.Fields{
+			"hook":      h.Name,
+			"hookEvent": string(h.On),
+		})
+		logger.Info("running lifecycle hook...")
+		var err error
+		if h.RunTask != nil {
+			err = r.runTask(logger, h, cause)
+		} else {
+			err = r.runCommand(logger, h, cause)
+		}
+		if err != nil {
+			logger.WithError(err).Error("lifecycle hook failed")
+			return NewErrorf("%s failed: %s", h, err)
+		}
+		logger.Info("lifecycle hook succeeded")
+	}
+	return nil
+}
+
+func (r *lifecycleHookRunner) environment(h *LifecycleHook, cause error) map[string]string {
+	envars := r.envars
+	ret := map[string]string{
+		HookEventKey:     string(h.On),
+		HookNameKey:      h.Name,
+		ClusterKey:       aws.StringValue(envars.Cluster),
+		ServiceKey:       aws.StringValue(envars.Service),
+		CanaryServiceKey: aws.StringValue(envars.CanaryService),
+	}
+	if r.nextTaskDefinition != nil {
+		ret[HookTaskDefinitionArnKey] = *r.nextTaskDefinition.TaskDefinitionArn
+	}
+	if r.service != nil {
+		ret[HookPreviousTaskDefinitionArnKey] = aws.StringValue(r.service.TaskDefinition)
+	}
+	if cause != nil {
+		ret[HookErrorKey] = cause.Error()
+	}
+	return ret
+}
+
+func (r *lifecycleHookRunner) runCommand(logger *log.Entry, h *LifecycleHook, cause error) error {
+	c, cancel := context.WithTimeout(context.Background(), h.timeout())
+	defer cancel()
+	cmd := exec.CommandContext(c, h.Command[0], h.Command[1:]...)
+	cmd.Env = os.Environ()
+	for k, v := range r.environment(h, cause) {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", k, v))
+	}
+	stdout := &hookOutput{logger: logger.WithField("stream", "stdout")}
+	stderr := &hookOutput{logger: logger.WithField("stream", "stderr")}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	err := cmd.Run()
+	stdout.Flush()
+	stderr.Flush()
+	if c.Err() == context.DeadlineExceeded {
+		return NewErrorf("command '%s' timed out after %s", strings.Join(h.Command, " "), h.timeout())
+	}
+	return err
+}
+
+func (r *lifecycleHookRunner) runTask(logger *log.Entry, h *LifecycleHook, cause error) error {
+	if r.service == nil || r.nextTaskDefinition == nil {
+		return NewErrorf("task can't be run before next task definition is registered")
+	}
+	return r.envars.runOneOffTask(r.ctx, logger, &oneOffTask{
+		service:        r.service,
+		taskDefinition: r.nextTaskDefinition,
+		container:      h.RunTask.Container,
+		command:        h.RunTask.Command,
+		environment:    r.environment(h, cause),
+		startedBy:      kHookStartedBy,
+		timeout:        h.timeout(),
+	})
+}
+
+// コマンドの出力を1行ずつログに出す
+type hookOutput struct {
+	logger *log.Entry
+	buf    []byte
+	mux    sync.Mutex
+}
+
+func (w *hookOutput) Write(p []byte) (int, error) {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	w.buf = append(w.buf, p...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+		w.logger.Info(string(w.buf[:i]))
+		w.buf = w.buf[i+1:]
+	}
+	return len(p), nil
+}
+
+func (w *hookOutput) Flush() {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	if len(w.buf) > 0 {
+		w.logger.Info(string(w.buf))
+		w.buf = nil
+	}
+}
@@ -0,0 +1,154 @@
+package cage
+
+import (
+	"fmt"
+	"github.com/apex/log"
+	"github.com/apex/log/handlers/memory"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidateLifecycleHooks(t *testing.T) {
+	valid := []*LifecycleHook{
+		{Name: "smoke", On: HookPostRollout, Command: []string{"./smoke.sh"}},
+		{Name: "migrate", On: HookPostCanaryHealthy, RunTask: &RunTaskHook{Command: []string{"migrate"}}, Timeout: "1h"},
+	}
+	assert.Nil(t, validateLifecycleHooks(valid))
+	for _, v := range []*LifecycleHook{
+		{On: HookPostRollout, Command: []string{"true"}},
+		{Name: "a", On: "post-canary", Command: []string{"true"}},
+		{Name: "a", On: HookPreCanary},
+		{Name: "a", On: HookPreCanary, Command: []string{"true"}, RunTask: &RunTaskHook{}},
+		{Name: "a", On: HookPreCanary, Command: []string{"true"}, Timeout: "10"},
+	} {
+		assert.NotNil(t, validateLifecycleHooks([]*LifecycleHook{v}), "%+v", v)
+	}
+}
+
+// 実行されたフックのイベントをファイルに追記するコマンド
+func recordHook(t *testing.T, event LifecycleHookEvent) (*LifecycleHook, string) {
+	dir, err := ioutil.TempDir("", "cage-hook")
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	return &LifecycleHook{
+		Name:    string(event),
+		On:      event,
+		Command: []string{"sh", "-c", fmt.Sprintf("echo $%s >> %s", HookEventKey, filepath.Join(dir, "events"))},
+	}, filepath.Join(dir, "events")
+}
+
+func TestEnvars_RollOut_LifecycleHooks(t *testing.T) {
+	envars := DefaultEnvars()
+	hook, path := recordHook(t, HookPreCanary)
+	defer os.RemoveAll(filepath.Dir(path))
+	for _, e := range []LifecycleHookEvent{HookPostRollout, HookPrePrimaryUpdate, HookOnFailure, HookPostCanaryHealthy} {
+		h := *hook
+		h.Name = string(e)
+		h.On = e
+		envars.LifecycleHooks = append(envars.LifecycleHooks, &h)
+	}
+	envars.LifecycleHooks = append(envars.LifecycleHooks, hook)
+	ctrl := gomock.NewController(t)
+	_, ctx := envars.Setup(ctrl, 2, "FARGATE")
+	result := envars.RollOut(ctx)
+	if result.Error != nil {
+		t.Fatalf(result.Error.Error())
+	}
+	d, _ := ioutil.ReadFile(path)
+	assert.Equal(t, "pre-canary\npost-canary-healthy\npre-primary-update\npost-rollout\n", string(d))
+}
+
+func TestEnvars_RollOut_LifecycleHookFailed(t *testing.T) {
+	for _, event := range []LifecycleHookEvent{HookPreCanary, HookPostCanaryHealthy, HookPrePrimaryUpdate} {
+		envars := DefaultEnvars()
+		onFailure, path := recordHook(t, HookOnFailure)
+		envars.LifecycleHooks = []*LifecycleHook{
+			{Name: "fail", On: event, Command: []string{"false"}},
+			onFailure,
+		}
+		ctrl := gomock.NewController(t)
+		mocker, ctx := envars.Setup(ctrl, 2, "FARGATE")
+		service, _ := mocker.GetService("service")
+		previous := *service.TaskDefinition
+		result := envars.RollOut(ctx)
+		assert.NotNil(t, result.Error, string(event))
+		// カナリアサービスを消してサービスはそのまま
+		assert.True(t, result.ServiceIntact, string(event))
+		assert.Equal(t, int64(1), mocker.ServiceSize())
+		assert.Equal(t, previous, *service.TaskDefinition)
+		d, _ := ioutil.ReadFile(path)
+		assert.Equal(t, "on-failure\n", string(d))
+		os.RemoveAll(filepath.Dir(path))
+	}
+}
+
+func TestEnvars_RollOut_PostRolloutHookFailed(t *testing.T) {
+	envars := DefaultEnvars()
+	envars.LifecycleHooks = []*LifecycleHook{
+		{Name: "smoke", On: HookPostRollout, Command: []string{"sh", "-c", "echo smoke test failed >&2; exit 1"}},
+	}
+	ctrl := gomock.NewController(t)
+	mocker, ctx := envars.Setup(ctrl, 2, "FARGATE")
+	handler := memory.New()
+	ctx.Logger = &log.Logger{Handler: handler, Level: log.InfoLevel}
+	service, _ := mocker.GetService("service")
+	previous := *service.TaskDefinition
+	result := envars.RollOut(ctx)
+	assert.NotNil(t, result.Error)
+	assert.True(t, result.RolledBack)
+	assert.Equal(t, ExitCodeRolledBack, result.ExitCode())
+	service, _ = mocker.GetService("service")
+	assert.Equal(t, previous, *service.TaskDefinition)
+	// コマンドの出力はログに出る
+	found := false
+	for _, e := range handler.Entries {
+		if e.Message == "smoke test failed" && e.Fields["stream"] == "stderr" {
+			found = true
+		}
+	}
+	assert.True(t, found)
+}
+
+func TestEnvars_RollOut_RunTaskHook(t *testing.T) {
+	for _, exitCode := range []int64{0, 1} {
+		envars := DefaultEnvars()
+		envars.LifecycleHooks = []*LifecycleHook{{
+			Name:    "migrate",
+			On:      HookPostCanaryHealthy,
+			RunTask: &RunTaskHook{Command: []string{"rake", "db:migrate"}},
+		}}
+		ctrl := gomock.NewController(t)
+		mocker, ctx := envars.Setup(ctrl, 2, "FARGATE")
+		mocker.RunTaskExitCode = exitCode
+		result := envars.RollOut(ctx)
+		var hookTask bool
+		for _, v := range mocker.Tasks {
+			if aws.StringValue(v.StartedBy) != kHookStartedBy {
+				continue
+			}
+			hookTask = true
+			assert.Equal(t, *result.TaskDefinitionArn, *v.TaskDefinitionArn)
+			override := v.Overrides.ContainerOverrides[0]
+			assert.Equal(t, []string{"rake", "db:migrate"}, aws.StringValueSlice(override.Command))
+			var env []string
+			for _, kv := range override.Environment {
+				env = append(env, *kv.Name+"="+*kv.Value)
+			}
+			assert.Contains(t, env, "CAGE_HOOK_EVENT=post-canary-healthy")
+		}
+		assert.True(t, hookTask)
+		if exitCode == 0 {
+			assert.Nil(t, result.Error)
+		} else if assert.NotNil(t, result.Error) {
+			assert.True(t, strings.Contains(result.Error.Error(), "exited with code 1"))
+			assert.True(t, result.ServiceIntact)
+		}
+	}
+}
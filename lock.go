@@ -0,0 +1,402 @@
+package cage
+
+import (
+	"fmt"
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/google/uuid"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// 同じサービスへのロールアウトが同時に走らないようにするためのロック
+// 有効期限つきで取得し、ロールアウト中はハートビートで期限を延長し続ける
+type Locker interface {
+	Lock(owner string) error
+	Heartbeat(owner string) error
+	Unlock(owner string) error
+	ForceUnlock() error
+}
+
+const LockBackendDynamoDB = "dynamodb"
+const LockBackendTag = "tag"
+const kDefaultLockTtl = time.Duration(60) * time.Second
+
+type LockedError struct {
+	Key       string
+	Owner     string
+	ExpiresAt time.Time
+}
+
+func (e *LockedError) Error() string {
+	return fmt.Sprintf(
+		"'%s' is locked by '%s' until %s. run 'cage unlock' if the lock is stale",
+		e.Key, e.Owner, e.ExpiresAt.Format(time.RFC3339),
+	)
+}
+
+func (envars *Envars) NewLocker(ctx *Context) (Locker, error) {
+	if isEmpty(envars.LockBackend) {
+		return nil, nil
+	}
+	switch *envars.LockBackend {
+	case LockBackendDynamoDB:
+		if isEmpty(envars.LockTable) {
+			return nil, NewErrorf("--lockTable [%s] is required for '%s' lock backend", LockTableKey, LockBackendDynamoDB)
+		}
+		if ctx.Dynamo == nil {
+			return nil, NewErrorf("dynamodb client is not configured")
+		}
+		return &DynamoLocker{
+			Dynamo: ctx.Dynamo,
+			Table:  *envars.LockTable,
+			Key:    fmt.Sprintf("%s/%s", *envars.Cluster, *envars.Service),
+			Ttl:    kDefaultLockTtl,
+			Clock:  ctx.clock(),
+		}, nil
+	case LockBackendTag:
+		return &TagLocker{
+			Ecs:     ctx.Ecs,
+			Cluster: *envars.Cluster,
+			Service: *envars.Service,
+			Ttl:     kDefaultLockTtl,
+			Clock:   ctx.clock(),
+		}, nil
+	}
+	return nil, NewErrorf("unknown lock backend '%s'", *envars.LockBackend)
+}
+
+func NewLockOwner() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	return fmt.Sprintf("%s/%s", host, uuid.New().String())
+}
+
+type HeldLock struct {
+	locker Locker
+	owner  string
+	stop   chan struct{}
+	done   chan struct{}
+	mux    sync.Mutex
+	err    error
+}
+
+// ロックを取得して、解放されるまで clock で interval ごとにハートビートを送る
+func AcquireLock(locker Locker, owner string, interval time.Duration, clock Clock, logger log.Interface) (*HeldLock, error) {
+	if err := locker.Lock(owner); err != nil {
+		return nil, err
+	}
+	l := &HeldLock{
+		locker: locker,
+		owner:  owner,
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	go func() {
+		defer close(l.done)
+		for {
+			select {
+			case <-l.stop:
+				return
+			case <-clock.NewTimer(interval).C:
+				if err := locker.Heartbeat(owner); err != nil {
+					// 期限が切れて他のロールアウトに取られたかもしれないので、もう保持しているとはみなさない
+					logger.WithField("owner", owner).WithError(err).Error("failed to send heartbeat for deployment lock")
+					l.mux.Lock()
+					l.err = NewErrorf("deployment lock has been lost: %s", err)
+					l.mux.Unlock()
+					return
+				}
+			}
+		}
+	}()
+	return l, nil
+}
+
+// ハートビートに失敗していたらエラーを返す。ロールアウトは次の変更をする前に確認する
+func (l *HeldLock) Err() error {
+	if l == nil {
+		return nil
+	}
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	return l.err
+}
+
+func (l *HeldLock) Release() error {
+	close(l.stop)
+	<-l.done
+	return l.locker.Unlock(l.owner)
+}
+
+// DynamoDB の条件付き書き込みによるロック
+// テーブルはパーティションキーとして文字列型の LockKey を持つ必要がある
+type DynamoLocker struct {
+	Dynamo dynamodbiface.DynamoDBAPI
+	Table  string
+	Key    string
+	Ttl    time.Duration
+	// 指定しなければ実際の時計
+	Clock Clock
+}
+
+func (l *DynamoLocker) now() time.Time {
+	if l.Clock == nil {
+		return time.Now()
+	}
+	return l.Clock.Now()
+}
+
+func (l *DynamoLocker) Lock(owner string) error {
+	_, err := l.Dynamo.PutItem(&dynamodb.PutItemInput{
+		TableName: aws.String(l.Table),
+		Item: map[string]*dynamodb.AttributeValue{
+			"LockKey":   {S: aws.String(l.Key)},
+			"Owner":     {S: aws.String(owner)},
+			"ExpiresAt": {N: aws.String(l.expiresAt())},
+		},
+		ConditionExpression: aws.String("attribute_not_exists(LockKey) OR ExpiresAt < :now"),
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":now": {N: aws.String(strconv.FormatInt(l.now().Unix(), 10))},
+		},
+	})
+	if isConditionalCheckFailed(err) {
+		return l.lockedError()
+	}
+	return err
+}
+
+func (l *DynamoLocker) Heartbeat(owner string) error {
+	_, err := l.Dynamo.UpdateItem(&dynamodb.UpdateItemInput{
+		TableName: aws.String(l.Table),
+		Key: map[string]*dynamodb.AttributeValue{
+			"LockKey": {S: aws.String(l.Key)},
+		},
+		UpdateExpression:    aws.String("SET ExpiresAt = :expiresAt"),
+		ConditionExpression: aws.String("#owner = :owner"),
+		ExpressionAttributeNames: map[string]*string{
+			"#owner": aws.String("Owner"),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":expiresAt": {N: aws.String(l.expiresAt())},
+			":owner":     {S: aws.String(owner)},
+		},
+	})
+	if isConditionalCheckFailed(err) {
+		return l.lockedError()
+	}
+	return err
+}
+
+func (l *DynamoLocker) Unlock(owner string) error {
+	_, err := l.Dynamo.DeleteItem(&dynamodb.DeleteItemInput{
+		TableName: aws.String(l.Table),
+		Key: map[string]*dynamodb.AttributeValue{
+			"LockKey": {S: aws.String(l.Key)},
+		},
+		ConditionExpression: aws.String("#owner = :owner"),
+		ExpressionAttributeNames: map[string]*string{
+			"#owner": aws.String("Owner"),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":owner": {S: aws.String(owner)},
+		},
+	})
+	if isConditionalCheckFailed(err) {
+		return l.lockedError()
+	}
+	return err
+}
+
+func (l *DynamoLocker) ForceUnlock() error {
+	_, err := l.Dynamo.DeleteItem(&dynamodb.DeleteItemInput{
+		TableName: aws.String(l.Table),
+		Key: map[string]*dynamodb.AttributeValue{
+			"LockKey": {S: aws.String(l.Key)},
+		},
+	})
+	return err
+}
+
+func (l *DynamoLocker) expiresAt() string {
+	return strconv.FormatInt(l.now().Add(l.Ttl).Unix(), 10)
+}
+
+func (l *DynamoLocker) lockedError() error {
+	ret := &LockedError{Key: l.Key, Owner: "unknown"}
+	o, err := l.Dynamo.GetItem(&dynamodb.GetItemInput{
+		TableName: aws.String(l.Table),
+		Key: map[string]*dynamodb.AttributeValue{
+			"LockKey": {S: aws.String(l.Key)},
+		},
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil || o.Item == nil {
+		return ret
+	}
+	if v, ok := o.Item["Owner"]; ok && v.S != nil {
+		ret.Owner = *v.S
+	}
+	if v, ok := o.Item["ExpiresAt"]; ok && v.N != nil {
+		if sec, err := strconv.ParseInt(*v.N, 10, 64); err == nil {
+			ret.ExpiresAt = time.Unix(sec, 0)
+		}
+	}
+	return ret
+}
+
+func isConditionalCheckFailed(err error) bool {
+	aerr, ok := err.(awserr.Error)
+	return ok && aerr.Code() == dynamodb.ErrCodeConditionalCheckFailedException
+}
+
+// サービスのタグによるロック。ベストエフォートで排他的ではない
+// タグには条件付きの書き込みがないので、同時にLockした2つがどちらも書き込み後の読み直しで自分を所有者と読むことがある
+// 読み直しで気づけるのは、自分より後に他が書いた場合だけ。確実に排他するならDynamoLockerを使う
+type TagLocker struct {
+	Ecs     ecsiface.ECSAPI
+	Cluster string
+	Service string
+	Ttl     time.Duration
+	// 指定しなければ実際の時計
+	Clock Clock
+}
+
+func (l *TagLocker) now() time.Time {
+	if l.Clock == nil {
+		return time.Now()
+	}
+	return l.Clock.Now()
+}
+
+const LockOwnerTagKey = "cage:lock-owner"
+const LockExpiresAtTagKey = "cage:lock-expires-at"
+
+func (l *TagLocker) Lock(owner string) error {
+	arn, err := l.serviceArn()
+	if err != nil {
+		return err
+	}
+	if current, err := l.current(arn); err != nil {
+		return err
+	} else if current != nil && current.Owner != owner && current.ExpiresAt.After(l.now()) {
+		return current
+	}
+	if err := l.tag(arn, owner); err != nil {
+		return err
+	}
+	if current, err := l.current(arn); err != nil {
+		return err
+	} else if current == nil || current.Owner != owner {
+		if current == nil {
+			current = &LockedError{Key: arn, Owner: "unknown"}
+		}
+		return current
+	}
+	return nil
+}
+
+func (l *TagLocker) Heartbeat(owner string) error {
+	arn, err := l.serviceArn()
+	if err != nil {
+		return err
+	}
+	if current, err := l.current(arn); err != nil {
+		return err
+	} else if current == nil || current.Owner != owner {
+		return NewErrorf("deployment lock on '%s' is no longer held by '%s'", arn, owner)
+	}
+	return l.tag(arn, owner)
+}
+
+func (l *TagLocker) Unlock(owner string) error {
+	arn, err := l.serviceArn()
+	if err != nil {
+		return err
+	}
+	if current, err := l.current(arn); err != nil {
+		return err
+	} else if current != nil && current.Owner != owner {
+		return current
+	}
+	return l.untag(arn)
+}
+
+func (l *TagLocker) ForceUnlock() error {
+	arn, err := l.serviceArn()
+	if err != nil {
+		return err
+	}
+	return l.untag(arn)
+}
+
+func (l *TagLocker) serviceArn() (string, error) {
+	return describeServiceArn(l.Ecs, l.Cluster, l.Service)
+}
+
+// タグはサービスのARNに付ける
+func describeServiceArn(api ecsiface.ECSAPI, cluster string, service string) (string, error) {
+	o, err := api.DescribeServices(&ecs.DescribeServicesInput{
+		Cluster:  aws.String(cluster),
+		Services: []*string{aws.String(service)},
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(o.Services) == 0 {
+		return "", NewErrorf("service '%s' not found in cluster '%s'", service, cluster)
+	}
+	return *o.Services[0].ServiceArn, nil
+}
+
+func (l *TagLocker) current(arn string) (*LockedError, error) {
+	o, err := l.Ecs.ListTagsForResource(&ecs.ListTagsForResourceInput{
+		ResourceArn: aws.String(arn),
+	})
+	if err != nil {
+		return nil, err
+	}
+	ret := &LockedError{Key: arn}
+	for _, tag := range o.Tags {
+		switch *tag.Key {
+		case LockOwnerTagKey:
+			ret.Owner = *tag.Value
+		case LockExpiresAtTagKey:
+			if sec, err := strconv.ParseInt(*tag.Value, 10, 64); err == nil {
+				ret.ExpiresAt = time.Unix(sec, 0)
+			}
+		}
+	}
+	if ret.Owner == "" {
+		return nil, nil
+	}
+	return ret, nil
+}
+
+func (l *TagLocker) tag(arn string, owner string) error {
+	_, err := l.Ecs.TagResource(&ecs.TagResourceInput{
+		ResourceArn: aws.String(arn),
+		Tags: []*ecs.Tag{
+			{Key: aws.String(LockOwnerTagKey), Value: aws.String(owner)},
+			{Key: aws.String(LockExpiresAtTagKey), Value: aws.String(strconv.FormatInt(l.now().Add(l.Ttl).Unix(), 10))},
+		},
+	})
+	return err
+}
+
+func (l *TagLocker) untag(arn string) error {
+	_, err := l.Ecs.UntagResource(&ecs.UntagResourceInput{
+		ResourceArn: aws.String(arn),
+		TagKeys:     []*string{aws.String(LockOwnerTagKey), aws.String(LockExpiresAtTagKey)},
+	})
+	return err
+}
@@ -0,0 +1,125 @@
+package cage
+
+import (
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/golang/mock/gomock"
+	"github.com/loilo-inc/canarycage/mock/mock_dynamodb"
+	"github.com/loilo-inc/canarycage/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func setupDynamo(ctrl *gomock.Controller, ctx *Context) *test.MockDynamo {
+	mocker := test.NewMockDynamo("LockKey")
+	dynamoMock := mock_dynamodb.NewMockDynamoDBAPI(ctrl)
+	dynamoMock.EXPECT().GetItem(gomock.Any()).DoAndReturn(mocker.GetItem).AnyTimes()
+	dynamoMock.EXPECT().PutItem(gomock.Any()).DoAndReturn(mocker.PutItem).AnyTimes()
+	dynamoMock.EXPECT().UpdateItem(gomock.Any()).DoAndReturn(mocker.UpdateItem).AnyTimes()
+	dynamoMock.EXPECT().DeleteItem(gomock.Any()).DoAndReturn(mocker.DeleteItem).AnyTimes()
+	ctx.Dynamo = dynamoMock
+	return mocker
+}
+
+func testLocker(t *testing.T, locker Locker, clock *testClock) {
+	assert.Nil(t, locker.Lock("a"))
+	assert.Nil(t, locker.Heartbeat("a"))
+	// 他のオーナーは期限内には取得できない
+	err := locker.Lock("b")
+	if assert.IsType(t, &LockedError{}, err) {
+		assert.Equal(t, "a", err.(*LockedError).Owner)
+	}
+	assert.NotNil(t, locker.Unlock("b"))
+	assert.NotNil(t, locker.Heartbeat("b"))
+	// 期限が切れたロックは取得できる
+	clock.NewTimer(kDefaultLockTtl * 2)
+	assert.Nil(t, locker.Lock("b"))
+	assert.NotNil(t, locker.Heartbeat("a"))
+	assert.Nil(t, locker.Unlock("b"))
+	assert.Nil(t, locker.Lock("a"))
+	// 強制解除
+	assert.Nil(t, locker.ForceUnlock())
+	assert.Nil(t, locker.Lock("b"))
+}
+
+func TestDynamoLocker(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := &Context{}
+	setupDynamo(ctrl, ctx)
+	clock := &testClock{now: time.Now()}
+	testLocker(t, &DynamoLocker{
+		Dynamo: ctx.Dynamo,
+		Table:  "cage-lock",
+		Key:    "cluster/service",
+		Ttl:    kDefaultLockTtl,
+		Clock:  clock,
+	}, clock)
+}
+
+func TestTagLocker(t *testing.T) {
+	envars := DefaultEnvars()
+	ctrl := gomock.NewController(t)
+	_, ctx := envars.Setup(ctrl, 1, "FARGATE")
+	clock := &testClock{now: time.Now()}
+	testLocker(t, &TagLocker{
+		Ecs:     ctx.Ecs,
+		Cluster: *envars.Cluster,
+		Service: *envars.Service,
+		Ttl:     kDefaultLockTtl,
+		Clock:   clock,
+	}, clock)
+}
+
+func TestEnvars_RollOut_Lock(t *testing.T) {
+	// ロールアウト中はロックを保持し、終わったら解放する
+	envars := DefaultEnvars()
+	envars.LockBackend = aws.String(LockBackendDynamoDB)
+	envars.LockTable = aws.String("cage-lock")
+	ctrl := gomock.NewController(t)
+	_, ctx := envars.Setup(ctrl, 2, "FARGATE")
+	dynamo := setupDynamo(ctrl, ctx)
+	result := envars.RollOut(ctx)
+	assert.Nil(t, result.Error)
+	assert.Equal(t, 0, len(dynamo.Tables["cage-lock"]))
+}
+
+func TestEnvars_RollOut_Locked(t *testing.T) {
+	// 他のロールアウトがロックを持っていたら何もせずに終了する
+	envars := DefaultEnvars()
+	envars.LockBackend = aws.String(LockBackendTag)
+	ctrl := gomock.NewController(t)
+	mocker, ctx := envars.Setup(ctrl, 2, "FARGATE")
+	locker, _ := envars.NewLocker(ctx)
+	assert.Nil(t, locker.Lock("other"))
+	result := envars.RollOut(ctx)
+	assert.IsType(t, &LockedError{}, result.Error)
+	assert.True(t, result.ServiceIntact)
+	assert.Equal(t, int64(1), mocker.ServiceSize())
+}
+
+type lostLocker struct {
+	Locker
+}
+
+func (l *lostLocker) Heartbeat(owner string) error {
+	return NewErrorf("lock is held by another owner")
+}
+
+func TestAcquireLock_Lost(t *testing.T) {
+	// ハートビートに失敗したらロックを失ったとみなす
+	ctrl := gomock.NewController(t)
+	ctx := &Context{}
+	setupDynamo(ctrl, ctx)
+	locker := &lostLocker{&DynamoLocker{Dynamo: ctx.Dynamo, Table: "cage-lock", Key: "cluster/service", Ttl: kDefaultLockTtl}}
+	lock, err := AcquireLock(locker, "a", kDefaultLockTtl/3, fakeClock{}, log.Log)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	assert.Nil(t, (*HeldLock)(nil).Err())
+	for lock.Err() == nil {
+		time.Sleep(time.Millisecond)
+	}
+	assert.Equal(t, "deployment lock has been lost: lock is held by another owner", lock.Err().Error())
+	assert.Nil(t, lock.Release())
+}
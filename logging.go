@@ -0,0 +1,86 @@
+package cage
+
+import (
+	"github.com/apex/log"
+	"github.com/apex/log/handlers/json"
+	"github.com/apex/log/handlers/text"
+	"github.com/aws/aws-sdk-go/aws"
+	"io"
+	"strings"
+	"unicode"
+)
+
+const LogFormatKey = "CAGE_LOG_FORMAT"
+const LogLevelKey = "CAGE_LOG_LEVEL"
+
+const LogFormatJson = "json"
+const LogFormatText = "text"
+const LogFormatPlain = "plain"
+
+// apex/logのデフォルトの標準ライブラリのlogに書くハンドラー
+var kPlainLogHandler = log.Log.(*log.Logger).Handler
+
+// plain: 従来通り標準ライブラリのlogの形式 (デフォルト)
+// text: 端末向けに色付けした形式
+// json: 1行に1つのJSON。ログの集約基盤でパースできるようにメッセージから絵文字を除く
+func ConfigureLogger(format string, level string, w io.Writer) error {
+	var handler log.Handler
+	switch format {
+	case "", LogFormatPlain:
+		handler = kPlainLogHandler
+	case LogFormatText:
+		handler = text.New(w)
+	case LogFormatJson:
+		handler = &emojiStripHandler{handler: json.New(w)}
+	default:
+		return NewErrorf("log format must be one of '%s', '%s' or '%s' but got '%s'", LogFormatJson, LogFormatText, LogFormatPlain, format)
+	}
+	l := log.InfoLevel
+	if level != "" {
+		var err error
+		if l, err = log.ParseLevel(level); err != nil {
+			return NewErrorf("invalid log level '%s': %s", level, err)
+		}
+	}
+	log.SetHandler(handler)
+	log.SetLevel(l)
+	return nil
+}
+
+type emojiStripHandler struct {
+	handler log.Handler
+}
+
+func (h *emojiStripHandler) HandleLog(e *log.Entry) error {
+	entry := *e
+	entry.Message = StripEmoji(e.Message)
+	return h.handler.HandleLog(&entry)
+}
+
+func StripEmoji(str string) string {
+	ret := strings.Map(func(r rune) rune {
+		if r >= 0x1F000 || unicode.Is(unicode.So, r) || r == 0xFE0F || r == 0x200D {
+			return -1
+		}
+		return r
+	}, str)
+	return strings.TrimSpace(ret)
+}
+
+// ロールアウトのログに付ける構造化フィールド
+func (envars *Envars) Logger() *log.Entry {
+	return envars.logger(nil)
+}
+
+// Context.Logger が指定されていればそちらに出す
+func (envars *Envars) logger(ctx *Context) *log.Entry {
+	base := log.Log
+	if ctx != nil && ctx.Logger != nil {
+		base = ctx.Logger
+	}
+	return base.WithFields(log.Fields{
+		"cluster": aws.StringValue(envars.Cluster),
+		"service": aws.StringValue(envars.Service),
+		"canary":  aws.StringValue(envars.CanaryService),
+	})
+}
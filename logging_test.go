@@ -0,0 +1,65 @@
+package cage
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/apex/log"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func recoverLogger() func() {
+	logger := log.Log.(*log.Logger)
+	handler, level := logger.Handler, logger.Level
+	return func() {
+		log.SetHandler(handler)
+		log.SetLevel(level)
+	}
+}
+
+func TestConfigureLogger_Json(t *testing.T) {
+	defer recoverLogger()()
+	var buf bytes.Buffer
+	assert.Nil(t, ConfigureLogger(LogFormatJson, "warn", &buf))
+	envars := DefaultEnvars()
+	envars.Logger().Info("ignored")
+	envars.Logger().WithField("phase", PhaseStarted).Warn("🤩 canary task is healthy!")
+	var entry struct {
+		Level   string                 `json:"level"`
+		Message string                 `json:"message"`
+		Fields  map[string]interface{} `json:"fields"`
+	}
+	assert.Nil(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, "warn", entry.Level)
+	assert.Equal(t, "canary task is healthy!", entry.Message)
+	assert.Equal(t, map[string]interface{}{
+		"cluster": "cage-test",
+		"service": "service",
+		"canary":  "service-canary",
+		"phase":   "started",
+	}, entry.Fields)
+}
+
+func TestConfigureLogger_Text(t *testing.T) {
+	defer recoverLogger()()
+	var buf bytes.Buffer
+	assert.Nil(t, ConfigureLogger(LogFormatText, "", &buf))
+	log.WithField("service", "service").Info("message")
+	assert.Contains(t, buf.String(), "message")
+	assert.Contains(t, buf.String(), "service")
+	assert.Nil(t, ConfigureLogger(LogFormatPlain, "debug", &buf))
+	assert.Equal(t, log.DebugLevel, log.Log.(*log.Logger).Level)
+}
+
+func TestConfigureLogger_Invalid(t *testing.T) {
+	defer recoverLogger()()
+	var buf bytes.Buffer
+	assert.NotNil(t, ConfigureLogger("xml", "", &buf))
+	assert.NotNil(t, ConfigureLogger(LogFormatJson, "verbose", &buf))
+}
+
+func TestStripEmoji(t *testing.T) {
+	assert.Equal(t, "service roll out has completed successfully!", StripEmoji("🎉service roll out has completed successfully!🎉"))
+	assert.Equal(t, "canary task is healthy!", StripEmoji("🤩 canary task is healthy!"))
+	assert.Equal(t, "service 'a' is STABLE", StripEmoji("service 'a' is STABLE"))
+}
@@ -0,0 +1,290 @@
+package cage
+
+import (
+	"fmt"
+	"github.com/apex/log"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// 複数のサービスを1回でロールアウトするためのマニフェスト
+// 各サービスは dependsOn のサービスがすべて成功してからロールアウトし、失敗したらそれに依存するサービスはスキップする
+type Manifest struct {
+	// 同時にロールアウトするサービスの数。デフォルトは1
+	Parallelism int                `json:"parallelism,omitempty"`
+	Services    []*ManifestService `json:"services"`
+	// contextの相対パスの基準。LoadManifestではマニフェストのディレクトリ
+	Dir string `json:"-"`
+}
+
+type ManifestService struct {
+	// dependsOnで参照する名前
+	Name string `json:"name"`
+	// deployコンテクストのディレクトリ
+	Context   string   `json:"context"`
+	DependsOn []string `json:"dependsOn,omitempty"`
+	// 指定したらdeployコンテクストとフラグより優先する
+	Region  string `json:"region,omitempty"`
+	Cluster string `json:"cluster,omitempty"`
+}
+
+func LoadManifest(path string) (*Manifest, error) {
+	ret := &Manifest{}
+	if _, err := ReadAndUnmarshalJson(path, ret); err != nil {
+		return nil, NewErrorf("failed to read and unmarshal manifest '%s': %s", path, err)
+	}
+	ret.Dir = filepath.Dir(path)
+	if err := ret.Validate(); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (m *Manifest) Validate() error {
+	if len(m.Services) == 0 {
+		return NewErrorf("services of manifest are required")
+	}
+	if m.Parallelism < 0 {
+		return NewErrorf("parallelism of manifest must be positive: %d", m.Parallelism)
+	}
+	services := make(map[string]*ManifestService)
+	for i, s := range m.Services {
+		if s.Name == "" || s.Context == "" {
+			return NewErrorf("name and context of services[%d] are required", i)
+		}
+		if _, ok := services[s.Name]; ok {
+			return NewErrorf("service '%s' is duplicated in manifest", s.Name)
+		}
+		services[s.Name] = s
+	}
+	for _, s := range m.Services {
+		for _, d := range s.DependsOn {
+			if _, ok := services[d]; !ok {
+				return NewErrorf("service '%s' depends on unknown service '%s'", s.Name, d)
+			}
+		}
+	}
+	// 循環していたら誰もロールアウトできない
+	visited := make(map[string]int)
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch visited[name] {
+		case 1:
+			return NewErrorf("dependsOn of manifest has a cycle: %s", strings.Join(append(path, name), " -> "))
+		case 2:
+			return nil
+		}
+		visited[name] = 1
+		for _, d := range services[name].DependsOn {
+			if err := visit(d, append(path, name)); err != nil {
+				return err
+			}
+		}
+		visited[name] = 2
+		return nil
+	}
+	for _, s := range m.Services {
+		if err := visit(s.Name, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// contextをDirからの相対パスとして解決する
+func (m *Manifest) ContextDir(s *ManifestService) string {
+	if filepath.IsAbs(s.Context) {
+		return s.Context
+	}
+	return filepath.Join(m.Dir, s.Context)
+}
+
+const (
+	ManifestServiceSucceeded = "succeeded"
+	ManifestServiceFailed    = "failed"
+	// 依存するサービスが失敗したのでロールアウトしなかった
+	ManifestServiceSkipped = "skipped"
+)
+
+type ManifestServiceResult struct {
+	Name   string
+	Status string
+	Envars *Envars
+	// スキップしたらnil
+	Result *RollOutResult
+	// スキップの原因になった依存先
+	BlockedBy string
+}
+
+type ManifestResult struct {
+	StartTime time.Time
+	EndTime   time.Time
+	// マニフェストの順
+	Services []*ManifestServiceResult
+}
+
+func (r *ManifestResult) Error() error {
+	var failed []string
+	for _, s := range r.Services {
+		if s.Status == ManifestServiceFailed {
+			failed = append(failed, fmt.Sprintf("%s (%s)", s.Name, s.Result.Error))
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return NewErrorf("%d of %d service(s) failed to roll out: %s", len(failed), len(r.Services), strings.Join(failed, ", "))
+}
+
+// 失敗したサービスのうち一番悪い終了コード (ServiceChanged > RolledBack > ServiceIntact)
+func (r *ManifestResult) ExitCode() int {
+	ret := ExitCodeOk
+	for _, s := range r.Services {
+		if s.Result != nil && s.Result.ExitCode() > ret {
+			ret = s.Result.ExitCode()
+		}
+	}
+	return ret
+}
+
+// サービスごとのEnvarsとContextを作る。ロールアウトを始める前にすべてのサービスについて呼ぶ
+type ManifestLoader func(s *ManifestService) (*Envars, *Context, error)
+
+// 設定がすべて読めてからロールアウトを始める。読めなければ何もせずにエラーを返す
+func RollOutManifest(m *Manifest, load ManifestLoader) (*ManifestResult, error) {
+	envars := make(map[string]*Envars)
+	contexts := make(map[string]*Context)
+	for _, s := range m.Services {
+		e, ctx, err := load(s)
+		if err != nil {
+			return nil, NewErrorf("failed to load service '%s': %s", s.Name, err)
+		}
+		envars[s.Name] = e
+		contexts[s.Name] = ctx
+	}
+	// 全体の開始と終了の時刻は最初のサービスの時計で測る
+	var clock Clock = systemClock{}
+	if len(m.Services) > 0 {
+		clock = contexts[m.Services[0].Name].clock()
+	}
+	ret := m.run(func(name string) *RollOutResult {
+		return envars[name].RollOut(contexts[name])
+	}, func(name string) log.Interface {
+		return envars[name].logger(contexts[name])
+	}, clock)
+	for _, s := range ret.Services {
+		s.Envars = envars[s.Name]
+	}
+	return ret, nil
+}
+
+type manifestDone struct {
+	name   string
+	result *RollOutResult
+}
+
+// logger はサービスごとのロガーを返す。どのサービスのログかわかるようにmanifestServiceを付ける
+// 開始と終了の時刻は clock で測る
+func (m *Manifest) run(rollOut func(name string) *RollOutResult, logger func(name string) log.Interface, clock Clock) *ManifestResult {
+	parallelism := m.Parallelism
+	if parallelism == 0 {
+		parallelism = 1
+	}
+	ret := &ManifestResult{StartTime: clock.Now()}
+	results := make(map[string]*ManifestServiceResult)
+	for _, s := range m.Services {
+		r := &ManifestServiceResult{Name: s.Name}
+		results[s.Name] = r
+		ret.Services = append(ret.Services, r)
+	}
+	started := make(map[string]bool)
+	done := make(chan manifestDone)
+	running := 0
+	for {
+		// スキップが他のサービスのスキップを呼ぶので、変わらなくなるまで繰り返す
+		for changed := true; changed; {
+			changed = false
+			for _, s := range m.Services {
+				if started[s.Name] || results[s.Name].Status != "" {
+					continue
+				}
+				blockedBy, ready := "", true
+				for _, d := range s.DependsOn {
+					switch results[d].Status {
+					case ManifestServiceSucceeded:
+					case ManifestServiceFailed, ManifestServiceSkipped:
+						if blockedBy == "" {
+							blockedBy = d
+						}
+					default:
+						ready = false
+					}
+				}
+				if blockedBy != "" {
+					results[s.Name].Status = ManifestServiceSkipped
+					results[s.Name].BlockedBy = blockedBy
+					logger(s.Name).WithFields(log.Fields{
+						"manifestService": s.Name,
+						"blockedBy":       blockedBy,
+					}).Warn("skipping roll out because dependency hasn't been rolled out")
+					changed = true
+				} else if ready && running < parallelism {
+					logger(s.Name).WithField("manifestService", s.Name).Info("starting roll out...")
+					started[s.Name] = true
+					running++
+					go func(name string) {
+						done <- manifestDone{name: name, result: rollOut(name)}
+					}(s.Name)
+				}
+			}
+		}
+		if running == 0 {
+			break
+		}
+		d := <-done
+		running--
+		r := results[d.name]
+		r.Result = d.result
+		if d.result.Error != nil {
+			r.Status = ManifestServiceFailed
+			logger(d.name).WithField("manifestService", d.name).WithError(d.result.Error).Error("roll out failed")
+		} else {
+			r.Status = ManifestServiceSucceeded
+			logger(d.name).WithField("manifestService", d.name).Info("roll out succeeded")
+		}
+	}
+	ret.EndTime = clock.Now()
+	return ret
+}
+
+// すべてのサービスの結果をまとめたMarkdown
+func ManifestSummary(result *ManifestResult) string {
+	var b strings.Builder
+	if result.Error() == nil {
+		fmt.Fprintf(&b, "## :tada: %d service(s) have been rolled out\n\n", len(result.Services))
+	} else {
+		b.WriteString("## :x: roll out of manifest has failed\n\n")
+	}
+	b.WriteString("| Service | Status | Duration | Exit code | Note |\n|---|---|---|---|---|\n")
+	for _, s := range result.Services {
+		duration, code, note := "-", "-", ""
+		if s.Result != nil {
+			duration = s.Result.EndTime.Sub(s.Result.StartTime).Truncate(time.Second).String()
+			code = fmt.Sprintf("%d", s.Result.ExitCode())
+			if s.Result.Error != nil {
+				note = strings.Replace(s.Result.Error.Error(), "|", "\\|", -1)
+			}
+		} else if s.BlockedBy != "" {
+			note = fmt.Sprintf("`%s` hasn't been rolled out", s.BlockedBy)
+		}
+		fmt.Fprintf(&b, "| %s | %s | %s | %s | %s |\n", s.Name, s.Status, duration, code, note)
+	}
+	fmt.Fprintf(&b, "\nTotal duration: %s\n\n", result.EndTime.Sub(result.StartTime).Truncate(time.Second))
+	for _, s := range result.Services {
+		if s.Result != nil && s.Envars != nil {
+			b.WriteString(RollOutSummary(s.Envars, s.Result))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,202 @@
+package cage
+
+import (
+	"fmt"
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLoadManifest(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "cage-manifest-test")
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "manifest.json")
+	_ = ioutil.WriteFile(path, []byte(`{
+  "parallelism": 2,
+  "services": [
+    {"name": "api", "context": "./api"},
+    {"name": "worker", "context": "/deploy/worker", "dependsOn": ["api"]}
+  ]
+}`), 0644)
+	m, err := LoadManifest(path)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	assert.Equal(t, 2, m.Parallelism)
+	assert.Equal(t, filepath.Join(dir, "api"), m.ContextDir(m.Services[0]))
+	assert.Equal(t, "/deploy/worker", m.ContextDir(m.Services[1]))
+	assert.Equal(t, []string{"api"}, m.Services[1].DependsOn)
+}
+
+func TestManifest_Validate(t *testing.T) {
+	for _, v := range []struct {
+		services []*ManifestService
+		err      string
+	}{
+		{nil, "services of manifest are required"},
+		{[]*ManifestService{{Name: "api"}}, "name and context of services[0] are required"},
+		{[]*ManifestService{{Name: "api", Context: "a"}, {Name: "api", Context: "b"}}, "service 'api' is duplicated in manifest"},
+		{[]*ManifestService{{Name: "api", Context: "a", DependsOn: []string{"db"}}}, "service 'api' depends on unknown service 'db'"},
+		{[]*ManifestService{
+			{Name: "api", Context: "a", DependsOn: []string{"scheduler"}},
+			{Name: "worker", Context: "b", DependsOn: []string{"api"}},
+			{Name: "scheduler", Context: "c", DependsOn: []string{"worker"}},
+		}, "dependsOn of manifest has a cycle: api -> scheduler -> worker -> api"},
+	} {
+		err := (&Manifest{Services: v.services}).Validate()
+		if assert.NotNil(t, err) {
+			assert.Equal(t, v.err, err.Error())
+		}
+	}
+	assert.Nil(t, (&Manifest{Services: []*ManifestService{
+		{Name: "api", Context: "a"},
+		{Name: "worker", Context: "b", DependsOn: []string{"api"}},
+		{Name: "scheduler", Context: "c", DependsOn: []string{"api", "worker"}},
+	}}).Validate())
+}
+
+// 順番と同時に動いた数を記録する
+type manifestRecorder struct {
+	started []string
+	running int
+	max     int
+	fail    map[string]bool
+	mux     sync.Mutex
+}
+
+func (r *manifestRecorder) rollOut(name string) *RollOutResult {
+	r.mux.Lock()
+	r.started = append(r.started, name)
+	r.running++
+	if r.running > r.max {
+		r.max = r.running
+	}
+	r.mux.Unlock()
+	time.Sleep(10 * time.Millisecond)
+	r.mux.Lock()
+	r.running--
+	r.mux.Unlock()
+	ret := &RollOutResult{ServiceIntact: true}
+	if r.fail[name] {
+		ret.Error = NewErrorf("%s failed", name)
+	}
+	return ret
+}
+
+func (r *manifestRecorder) logger(name string) log.Interface {
+	return log.Log
+}
+
+func TestManifest_Run(t *testing.T) {
+	m := &Manifest{
+		Parallelism: 2,
+		Services: []*ManifestService{
+			{Name: "scheduler", Context: "c", DependsOn: []string{"api", "worker"}},
+			{Name: "worker", Context: "b", DependsOn: []string{"api"}},
+			{Name: "api", Context: "a"},
+			{Name: "batch", Context: "d", DependsOn: []string{"api"}},
+		},
+	}
+	rec := &manifestRecorder{}
+	result := m.run(rec.rollOut, rec.logger, systemClock{})
+	assert.Nil(t, result.Error())
+	assert.Equal(t, ExitCodeOk, result.ExitCode())
+	assert.Equal(t, "api", rec.started[0])
+	assert.Equal(t, "scheduler", rec.started[3])
+	assert.Equal(t, 2, rec.max)
+	// 結果はマニフェストの順
+	for i, s := range result.Services {
+		assert.Equal(t, m.Services[i].Name, s.Name)
+		assert.Equal(t, ManifestServiceSucceeded, s.Status)
+	}
+	// デフォルトは1つずつ
+	m.Parallelism = 0
+	rec = &manifestRecorder{}
+	m.run(rec.rollOut, rec.logger, systemClock{})
+	assert.Equal(t, 1, rec.max)
+}
+
+func TestManifest_RunFailed(t *testing.T) {
+	m := &Manifest{
+		Parallelism: 3,
+		Services: []*ManifestService{
+			{Name: "api", Context: "a"},
+			{Name: "worker", Context: "b", DependsOn: []string{"api"}},
+			{Name: "scheduler", Context: "c", DependsOn: []string{"worker"}},
+			{Name: "admin", Context: "d"},
+		},
+	}
+	rec := &manifestRecorder{fail: map[string]bool{"api": true}}
+	result := m.run(rec.rollOut, rec.logger, systemClock{})
+	// 依存しないサービスは続ける
+	sort.Strings(rec.started)
+	assert.Equal(t, []string{"admin", "api"}, rec.started)
+	statuses := make(map[string]*ManifestServiceResult)
+	for _, s := range result.Services {
+		statuses[s.Name] = s
+	}
+	assert.Equal(t, ManifestServiceFailed, statuses["api"].Status)
+	assert.Equal(t, ManifestServiceSkipped, statuses["worker"].Status)
+	assert.Equal(t, "api", statuses["worker"].BlockedBy)
+	assert.Equal(t, ManifestServiceSkipped, statuses["scheduler"].Status)
+	assert.Equal(t, "worker", statuses["scheduler"].BlockedBy)
+	assert.Equal(t, ManifestServiceSucceeded, statuses["admin"].Status)
+	if assert.NotNil(t, result.Error()) {
+		assert.Equal(t, "1 of 4 service(s) failed to roll out: api (api failed)", result.Error().Error())
+	}
+	assert.Equal(t, ExitCodeServiceIntact, result.ExitCode())
+	summary := ManifestSummary(result)
+	assert.True(t, strings.Contains(summary, "| worker | skipped | - | - | `api` hasn't been rolled out |"), summary)
+}
+
+func TestRollOutManifest(t *testing.T) {
+	m := &Manifest{
+		Parallelism: 2,
+		Services: []*ManifestService{
+			{Name: "api", Context: "api"},
+			{Name: "worker", Context: "worker", DependsOn: []string{"api"}},
+		},
+	}
+	ctrl := gomock.NewController(t)
+	result, err := RollOutManifest(m, func(s *ManifestService) (*Envars, *Context, error) {
+		envars := DefaultEnvars()
+		envars.Service = aws.String(s.Name)
+		envars.CanaryService = aws.String(fmt.Sprintf("%s-canary", s.Name))
+		_, ctx := envars.Setup(ctrl, 1, "FARGATE")
+		return envars, ctx, nil
+	})
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	assert.Nil(t, result.Error())
+	for _, s := range result.Services {
+		assert.Equal(t, ManifestServiceSucceeded, s.Status)
+		assert.Equal(t, s.Name, *s.Envars.Service)
+		assert.NotNil(t, s.Result.TaskDefinitionArn)
+	}
+	summary := ManifestSummary(result)
+	assert.True(t, strings.Contains(summary, "2 service(s) have been rolled out"))
+	assert.True(t, strings.Contains(summary, "`worker` has been rolled out"))
+	// 設定が読めなければ何もロールアウトしない
+	envars := DefaultEnvars()
+	mocker, ctx := envars.Setup(ctrl, 1, "FARGATE")
+	_, err = RollOutManifest(m, func(s *ManifestService) (*Envars, *Context, error) {
+		if s.Name == "worker" {
+			return nil, nil, NewErrorf("no service.json")
+		}
+		return envars, ctx, nil
+	})
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "failed to load service 'worker': no service.json", err.Error())
+	}
+	assert.Equal(t, 1, len(mocker.TaskDefinitions))
+}
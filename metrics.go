@@ -0,0 +1,179 @@
+package cage
+
+import (
+	"github.com/apex/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/push"
+	"time"
+)
+
+// デプロイの統計をPushgatewayに送る
+// Pushgatewayはグループ (job, cluster, service, command) ごとに最後に送られた値を保持する
+// 結果ごとの最後の時刻はoutcomeを加えたグループに、ロールバックの最後の時刻はeventを加えたグループに送る
+// Pushgatewayではカウンターが増えないので、回数や割合は最後の時刻の changes() で数える
+const kMetricsJob = "cage"
+
+const DeployOutcomeSucceeded = "succeeded"
+const DeployOutcomeFailed = "failed"
+const DeployOutcomeRolledBack = "rolled_back"
+
+type DeployStats struct {
+	// rollout | up
+	Command          string
+	Cluster          string
+	Service          string
+	StartTime        time.Time
+	EndTime          time.Time
+	Outcome          string
+	PhaseDurations   map[RollOutPhase]time.Duration
+	CanaryHealthWait time.Duration
+	RolledBack       bool
+}
+
+var kPhaseOrder = []RollOutPhase{PhaseStarted, PhaseCanaryHealthy, PhaseAwaitingApproval, PhasePrimaryUpdating}
+
+// 各フェーズに入ってから次のフェーズ (なければ終了) までの時間
+func (r *RollOutResult) PhaseDurations() map[RollOutPhase]time.Duration {
+	ret := make(map[RollOutPhase]time.Duration)
+	for i, phase := range kPhaseOrder {
+		start, ok := r.PhaseTimes[phase]
+		if !ok {
+			continue
+		}
+		end := r.EndTime
+		for _, next := range kPhaseOrder[i+1:] {
+			if t, ok := r.PhaseTimes[next]; ok {
+				end = t
+				break
+			}
+		}
+		ret[phase] = end.Sub(start)
+	}
+	return ret
+}
+
+func DeployOutcome(err error, rolledBack bool) string {
+	if rolledBack {
+		return DeployOutcomeRolledBack
+	} else if err != nil {
+		return DeployOutcomeFailed
+	}
+	return DeployOutcomeSucceeded
+}
+
+func (envars *Envars) NewDeployStats(result *RollOutResult) *DeployStats {
+	return &DeployStats{
+		Command:          "rollout",
+		Cluster:          *envars.Cluster,
+		Service:          *envars.Service,
+		StartTime:        result.StartTime,
+		EndTime:          result.EndTime,
+		Outcome:          DeployOutcome(result.Error, result.RolledBack),
+		PhaseDurations:   result.PhaseDurations(),
+		CanaryHealthWait: result.CanaryHealthWait,
+		RolledBack:       result.RolledBack,
+	}
+}
+
+func (stats *DeployStats) Registry() *prometheus.Registry {
+	reg := prometheus.NewRegistry()
+	duration := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "cage_deploy_duration_seconds",
+		Help: "Duration of the last deploy",
+	})
+	duration.Set(stats.EndTime.Sub(stats.StartTime).Seconds())
+	timestamp := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "cage_deploy_timestamp_seconds",
+		Help: "Unix time when the last deploy finished",
+	})
+	timestamp.Set(float64(stats.EndTime.Unix()))
+	phases := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "cage_deploy_phase_duration_seconds",
+		Help: "Duration of each phase of the last deploy",
+	}, []string{"phase"})
+	for phase, d := range stats.PhaseDurations {
+		phases.WithLabelValues(string(phase)).Set(d.Seconds())
+	}
+	healthWait := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "cage_canary_health_wait_seconds",
+		Help: "Time waited until the canary task became healthy",
+	})
+	healthWait.Set(stats.CanaryHealthWait.Seconds())
+	// Pushgatewayはグループごとに値を置き換えるのでカウンターは増えない。最後の結果だけを1にする
+	outcome := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "cage_deploy_outcome",
+		Help: "1 for the outcome of the last deploy",
+	}, []string{"outcome"})
+	outcome.WithLabelValues(stats.Outcome).Set(1)
+	rolledBack := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "cage_deploy_rolled_back",
+		Help: "1 if the last deploy was rolled back, otherwise 0",
+	})
+	if stats.RolledBack {
+		rolledBack.Set(1)
+	}
+	reg.MustRegister(duration, timestamp, phases, healthWait, outcome, rolledBack)
+	return reg
+}
+
+// 結果ごとのグループに送るメトリクス。他の結果のグループは置き換えないので、結果ごとに最後の時刻が残る
+func (stats *DeployStats) OutcomeRegistry() *prometheus.Registry {
+	reg := prometheus.NewRegistry()
+	last := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "cage_deploy_last_outcome_timestamp_seconds",
+		Help: "Unix time when the last deploy with the outcome finished",
+	})
+	last.Set(float64(stats.EndTime.Unix()))
+	reg.MustRegister(last)
+	return reg
+}
+
+// ロールバックしたときだけ送る。ロールバックしなかったデプロイでは置き換えないので、最後のロールバックの時刻が残る
+func (stats *DeployStats) RollbackRegistry() *prometheus.Registry {
+	reg := prometheus.NewRegistry()
+	last := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "cage_deploy_last_rollback_timestamp_seconds",
+		Help: "Unix time when the last deploy that was rolled back finished",
+	})
+	last.Set(float64(stats.EndTime.Unix()))
+	reg.MustRegister(last)
+	return reg
+}
+
+func (stats *DeployStats) Push(url string) error {
+	if err := stats.pusher(url).Gatherer(stats.Registry()).Push(); err != nil {
+		return err
+	}
+	if err := stats.pusher(url).
+		Grouping("outcome", stats.Outcome).
+		Gatherer(stats.OutcomeRegistry()).
+		Push(); err != nil {
+		return err
+	}
+	if !stats.RolledBack {
+		return nil
+	}
+	return stats.pusher(url).
+		Grouping("event", "rollback").
+		Gatherer(stats.RollbackRegistry()).
+		Push()
+}
+
+func (stats *DeployStats) pusher(url string) *push.Pusher {
+	return push.New(url, kMetricsJob).
+		Grouping("cluster", stats.Cluster).
+		Grouping("service", stats.Service).
+		Grouping("command", stats.Command)
+}
+
+// 失敗してもデプロイの結果には影響させない
+func (stats *DeployStats) PushIfConfigured(url *string, logger log.Interface) {
+	if isEmpty(url) {
+		return
+	}
+	logger = logger.WithField("pushgatewayUrl", *url)
+	logger.Info("pushing deploy metrics...")
+	if err := stats.Push(*url); err != nil {
+		logger.WithError(err).Warn("failed to push deploy metrics")
+	}
+}
@@ -0,0 +1,122 @@
+package cage
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type pushRecord struct {
+	Method string
+	Path   string
+	Body   string
+}
+
+func newPushgateway(records *[]pushRecord, mux *sync.Mutex) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		d, _ := ioutil.ReadAll(r.Body)
+		mux.Lock()
+		defer mux.Unlock()
+		*records = append(*records, pushRecord{Method: r.Method, Path: r.URL.Path, Body: string(d)})
+		w.WriteHeader(http.StatusAccepted)
+	}))
+}
+
+func TestRollOutResult_PhaseDurations(t *testing.T) {
+	start := time.Unix(1000, 0)
+	result := &RollOutResult{
+		StartTime: start,
+		EndTime:   start.Add(100 * time.Second),
+		PhaseTimes: map[RollOutPhase]time.Time{
+			PhaseStarted:         start,
+			PhaseCanaryHealthy:   start.Add(60 * time.Second),
+			PhasePrimaryUpdating: start.Add(61 * time.Second),
+		},
+	}
+	d := result.PhaseDurations()
+	assert.Equal(t, 60*time.Second, d[PhaseStarted])
+	assert.Equal(t, 1*time.Second, d[PhaseCanaryHealthy])
+	assert.Equal(t, 39*time.Second, d[PhasePrimaryUpdating])
+	// カナリアで失敗した場合は終了までがstartedの時間
+	result.PhaseTimes = map[RollOutPhase]time.Time{PhaseStarted: start}
+	assert.Equal(t, 100*time.Second, result.PhaseDurations()[PhaseStarted])
+}
+
+func TestDeployOutcome(t *testing.T) {
+	assert.Equal(t, DeployOutcomeSucceeded, DeployOutcome(nil, false))
+	assert.Equal(t, DeployOutcomeFailed, DeployOutcome(errors.New("err"), false))
+	assert.Equal(t, DeployOutcomeRolledBack, DeployOutcome(errors.New("err"), true))
+}
+
+func TestEnvars_RollOut_PushMetrics(t *testing.T) {
+	var records []pushRecord
+	var mux sync.Mutex
+	server := newPushgateway(&records, &mux)
+	defer server.Close()
+	envars := DefaultEnvars()
+	envars.PushgatewayUrl = &server.URL
+	_, ctx := envars.Setup(gomock.NewController(t), 2, "FARGATE")
+	result := envars.RollOut(ctx)
+	assert.Nil(t, result.Error)
+	mux.Lock()
+	defer mux.Unlock()
+	if assert.Equal(t, 2, len(records)) {
+		for _, r := range records {
+			assert.Equal(t, "PUT", r.Method)
+			// グループのラベルの順番は不定
+			assert.True(t, strings.HasPrefix(r.Path, "/metrics/job/cage/"), r.Path)
+			for _, v := range []string{"/cluster/cage-test", "/service/service", "/command/rollout"} {
+				assert.True(t, strings.Contains(r.Path, v), r.Path)
+			}
+		}
+		// 結果ごとの時刻は他の結果を置き換えないように別のグループに送る
+		assert.False(t, strings.Contains(records[0].Path, "/outcome/"), records[0].Path)
+		assert.True(t, strings.Contains(records[1].Path, "/outcome/succeeded"), records[1].Path)
+	}
+}
+
+func TestDeployStats_Push(t *testing.T) {
+	var records []pushRecord
+	var mux sync.Mutex
+	server := newPushgateway(&records, &mux)
+	defer server.Close()
+	start := time.Unix(1000, 0)
+	stats := &DeployStats{
+		Command:          "rollout",
+		Cluster:          "cluster",
+		Service:          "service",
+		StartTime:        start,
+		EndTime:          start.Add(120 * time.Second),
+		Outcome:          DeployOutcomeRolledBack,
+		PhaseDurations:   map[RollOutPhase]time.Duration{PhaseStarted: 30 * time.Second},
+		CanaryHealthWait: 15 * time.Second,
+		RolledBack:       true,
+	}
+	assert.Nil(t, stats.Push(server.URL))
+	// protobufで送られるので名前とラベルが含まれることだけ確認する
+	body := records[0].Body
+	for _, v := range []string{
+		"cage_deploy_duration_seconds",
+		"cage_deploy_phase_duration_seconds",
+		"cage_canary_health_wait_seconds",
+		"cage_deploy_outcome",
+		"rolled_back",
+		"cage_deploy_rolled_back",
+	} {
+		assert.True(t, strings.Contains(body, v), v)
+	}
+	assert.True(t, strings.Contains(records[1].Path, "/outcome/rolled_back"), records[1].Path)
+	assert.True(t, strings.Contains(records[1].Body, "cage_deploy_last_outcome_timestamp_seconds"))
+	// ロールバックの時刻はロールバックしたときだけ別のグループに送る
+	if assert.Equal(t, 3, len(records)) {
+		assert.True(t, strings.Contains(records[2].Path, "/event/rollback"), records[2].Path)
+		assert.True(t, strings.Contains(records[2].Body, "cage_deploy_last_rollback_timestamp_seconds"))
+	}
+}
@@ -0,0 +1,66 @@
+package cage
+
+import (
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"time"
+)
+
+const kDefaultMigrationTimeout = time.Duration(30) * time.Minute
+const kMigrationStartedBy = "cage-migrate"
+
+// deployコンテクストの cage.json の "migrate" に書く
+// カナリアを作る前に、次のタスク定義でコマンドを上書きしたタスクをサービスと同じネットワークで1回だけ動かす
+type MigrationConfig struct {
+	// コマンドを上書きするコンテナ。省略すると最初のessentialなコンテナ
+	Container string   `json:"container,omitempty"`
+	Command   []string `json:"command"`
+	// 例: "1h"。デフォルトは30分
+	Timeout string `json:"timeout,omitempty"`
+}
+
+func (m *MigrationConfig) timeout() time.Duration {
+	if d, err := time.ParseDuration(m.Timeout); err == nil && m.Timeout != "" {
+		return d
+	}
+	return kDefaultMigrationTimeout
+}
+
+func validateMigration(m *MigrationConfig) error {
+	if m == nil {
+		return nil
+	}
+	if len(m.Command) == 0 {
+		return NewErrorf("command of migrate is required")
+	}
+	if m.Timeout != "" {
+		if d, err := time.ParseDuration(m.Timeout); err != nil || d <= 0 {
+			return NewErrorf("timeout of migrate must be a positive duration: '%s'", m.Timeout)
+		}
+	}
+	return nil
+}
+
+// マイグレーションのタスクを動かして、essentialなコンテナが0で終了するまで待つ
+func (envars *Envars) RunMigration(ctx *Context, service *ecs.Service, nextTaskDefinition *ecs.TaskDefinition) error {
+	m := envars.Migration
+	span := ctx.Tracer.Start("migrate")
+	span.SetAttribute("ecs.task_definition", *nextTaskDefinition.TaskDefinitionArn)
+	logger := envars.logger(ctx).WithField("taskDefinition", *nextTaskDefinition.TaskDefinitionArn)
+	logger.Info("running migration task...")
+	err := envars.runOneOffTask(ctx, logger, &oneOffTask{
+		service:        service,
+		taskDefinition: nextTaskDefinition,
+		container:      m.Container,
+		command:        m.Command,
+		startedBy:      kMigrationStartedBy,
+		timeout:        m.timeout(),
+	})
+	span.SetError(err)
+	span.End()
+	if err != nil {
+		logger.WithError(err).Error("migration task failed")
+		return NewErrorf("migration failed: %s", err)
+	}
+	logger.Info("migration task has completed")
+	return nil
+}
@@ -0,0 +1,159 @@
+package cage
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"github.com/apex/log"
+	"github.com/apex/log/handlers/memory"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/loilo-inc/canarycage/mock/mock_cloudwatchlogs"
+	"github.com/loilo-inc/canarycage/test"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidateMigration(t *testing.T) {
+	assert.Nil(t, validateMigration(nil))
+	assert.Nil(t, validateMigration(&MigrationConfig{Command: []string{"migrate"}, Timeout: "1h"}))
+	assert.NotNil(t, validateMigration(&MigrationConfig{}))
+	assert.NotNil(t, validateMigration(&MigrationConfig{Command: []string{"migrate"}, Timeout: "-1m"}))
+}
+
+func migrationTasks(mocker *test.MockContext) []*ecs.Task {
+	var ret []*ecs.Task
+	for _, v := range mocker.Tasks {
+		if aws.StringValue(v.StartedBy) == kMigrationStartedBy {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+func TestEnvars_RollOut_Migration(t *testing.T) {
+	envars := DefaultEnvars()
+	envars.Migration = &MigrationConfig{Command: []string{"rake", "db:migrate"}}
+	ctrl := gomock.NewController(t)
+	mocker, ctx := envars.Setup(ctrl, 2, "FARGATE")
+	result := envars.RollOut(ctx)
+	if result.Error != nil {
+		t.Fatalf(result.Error.Error())
+	}
+	tasks := migrationTasks(mocker)
+	if assert.Equal(t, 1, len(tasks)) {
+		assert.Equal(t, *result.TaskDefinitionArn, *tasks[0].TaskDefinitionArn)
+		assert.Equal(t, []string{"rake", "db:migrate"}, aws.StringValueSlice(tasks[0].Overrides.ContainerOverrides[0].Command))
+	}
+}
+
+func TestEnvars_RollOut_MigrationFailed(t *testing.T) {
+	envars := DefaultEnvars()
+	envars.Migration = &MigrationConfig{Command: []string{"rake", "db:migrate"}}
+	ctrl := gomock.NewController(t)
+	mocker, ctx := envars.Setup(ctrl, 2, "FARGATE")
+	mocker.RunTaskExitCode = 1
+	result := envars.RollOut(ctx)
+	if assert.NotNil(t, result.Error) {
+		assert.True(t, strings.Contains(result.Error.Error(), "exited with code 1"))
+	}
+	assert.True(t, result.ServiceIntact)
+	// カナリアは作らない
+	_, ok := mocker.GetService(*envars.CanaryService)
+	assert.False(t, ok)
+	assert.Equal(t, 1, len(migrationTasks(mocker)))
+}
+
+func TestEnvars_RunMigration_Logs(t *testing.T) {
+	envars := DefaultEnvars()
+	d, _ := base64.StdEncoding.DecodeString(*envars.TaskDefinitionBase64)
+	td := &ecs.RegisterTaskDefinitionInput{}
+	_ = json.Unmarshal(d, td)
+	td.ContainerDefinitions[0].Name = aws.String("app")
+	td.ContainerDefinitions[0].LogConfiguration = &ecs.LogConfiguration{
+		LogDriver: aws.String("awslogs"),
+		Options: map[string]*string{
+			"awslogs-group":         aws.String("/ecs/app"),
+			"awslogs-stream-prefix": aws.String("ecs"),
+		},
+	}
+	d, _ = json.Marshal(td)
+	envars.TaskDefinitionBase64 = aws.String(base64.StdEncoding.EncodeToString(d))
+	envars.Migration = &MigrationConfig{Command: []string{"rake", "db:migrate"}}
+	ctrl := gomock.NewController(t)
+	mocker, ctx := envars.Setup(ctrl, 1, "FARGATE")
+	logsMock := mock_cloudwatchlogs.NewMockCloudWatchLogsAPI(ctrl)
+	var streams []string
+	logsMock.EXPECT().GetLogEvents(gomock.Any()).DoAndReturn(func(input *cloudwatchlogs.GetLogEventsInput) (*cloudwatchlogs.GetLogEventsOutput, error) {
+		streams = append(streams, *input.LogStreamName)
+		if input.NextToken == nil {
+			return &cloudwatchlogs.GetLogEventsOutput{
+				Events: []*cloudwatchlogs.OutputLogEvent{
+					{Message: aws.String("migrating...")},
+					{Message: aws.String("migrated")},
+				},
+				NextForwardToken: aws.String("f/1"),
+			}, nil
+		}
+		return &cloudwatchlogs.GetLogEventsOutput{NextForwardToken: input.NextToken}, nil
+	}).MinTimes(2)
+	ctx.Logs = logsMock
+	handler := memory.New()
+	ctx.Logger = &log.Logger{Handler: handler, Level: log.InfoLevel}
+	next, err := envars.CreateNextTaskDefinition(ctx.Ecs)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	service, _ := mocker.GetService(*envars.Service)
+	assert.Nil(t, envars.RunMigration(ctx, service, next))
+	tasks := migrationTasks(mocker)
+	if assert.Equal(t, 1, len(tasks)) {
+		assert.Equal(t, "ecs/app/"+*tasks[0].TaskArn, streams[0])
+	}
+	var messages []string
+	for _, e := range handler.Entries {
+		if e.Fields["logStream"] != nil {
+			messages = append(messages, e.Message)
+		}
+	}
+	assert.Equal(t, []string{"migrating...", "migrated"}, messages)
+}
+
+func TestEnvars_RunMigration_TimedOut(t *testing.T) {
+	envars := DefaultEnvars()
+	envars.Migration = &MigrationConfig{Command: []string{"rake", "db:migrate"}, Timeout: "1m"}
+	ctrl := gomock.NewController(t)
+	mocker, ctx := envars.Setup(ctrl, 1, "FARGATE")
+	mocker.RunTaskKeepsRunning = true
+	// 待ち時間はctxの時計で進む
+	ctx.Clock = &testClock{now: time.Now()}
+	next, err := envars.CreateNextTaskDefinition(ctx.Ecs)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	service, _ := mocker.GetService(*envars.Service)
+	err = envars.RunMigration(ctx, service, next)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "hasn't stopped within 1m0s")
+	}
+	// 止めたタスクは残らない
+	assert.Equal(t, 0, len(migrationTasks(mocker)))
+}
+
+func TestOneOffTask_NetworkConfiguration(t *testing.T) {
+	network := &ecs.NetworkConfiguration{
+		AwsvpcConfiguration: &ecs.AwsVpcConfiguration{Subnets: aws.StringSlice([]string{"subnet-1"})},
+	}
+	task := &oneOffTask{service: &ecs.Service{NetworkConfiguration: network}}
+	assert.Equal(t, network, task.networkConfiguration())
+	// EXTERNALやCODE_DEPLOYのサービスはプライマリのタスクセットから読む
+	task = &oneOffTask{service: &ecs.Service{TaskSets: []*ecs.TaskSet{
+		{Status: aws.String("ACTIVE")},
+		{Status: aws.String("PRIMARY"), NetworkConfiguration: network},
+	}}}
+	assert.Equal(t, network, task.networkConfiguration())
+	assert.Nil(t, (&oneOffTask{service: &ecs.Service{}}).networkConfiguration())
+}
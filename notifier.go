@@ -0,0 +1,321 @@
+package cage
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"net/http"
+	"sync"
+	"time"
+)
+
+type RollOutPhase string
+
+const (
+	PhaseStarted       RollOutPhase = "started"
+	PhaseCanaryHealthy RollOutPhase = "canary_healthy"
+	// 承認を待って止まっている
+	PhaseAwaitingApproval RollOutPhase = "awaiting_approval"
+	PhasePrimaryUpdating  RollOutPhase = "primary_updating"
+	PhaseSucceeded        RollOutPhase = "succeeded"
+	PhaseFailed           RollOutPhase = "failed"
+	PhaseRolledBack       RollOutPhase = "rolled_back"
+)
+
+const NotifierTypeSlack = "slack"
+const NotifierTypeWebhook = "webhook"
+
+const kNotifyMaxAttempts = 3
+const kNotifyRetryInterval = time.Duration(2) * time.Second
+const kNotifyHttpTimeout = time.Duration(10) * time.Second
+
+// ロールアウト終了時に送信中の通知を待つ最大時間
+const kNotifyWaitTimeout = time.Duration(30) * time.Second
+
+// deployコンテクストの cage.json の "notifiers" に書く
+type NotifierConfig struct {
+	Type     string            `json:"type"`
+	Url      string            `json:"url"`
+	Channel  string            `json:"channel,omitempty"`
+	Username string            `json:"username,omitempty"`
+	Headers  map[string]string `json:"headers,omitempty"`
+}
+
+type RollOutEvent struct {
+	Phase             RollOutPhase `json:"phase"`
+	Cluster           string       `json:"cluster"`
+	Service           string       `json:"service"`
+	CanaryService     string       `json:"canaryService"`
+	TaskDefinitionArn string       `json:"taskDefinitionArn,omitempty"`
+	ServiceIntact     bool         `json:"serviceIntact"`
+	Error             string       `json:"error,omitempty"`
+	Time              time.Time    `json:"time"`
+}
+
+type Notifier interface {
+	Notify(event *RollOutEvent) error
+}
+
+func NewNotifier(config *NotifierConfig) (Notifier, error) {
+	if config.Url == "" {
+		return nil, NewErrorf("url of '%s' notifier is required", config.Type)
+	}
+	client := &http.Client{Timeout: kNotifyHttpTimeout}
+	switch config.Type {
+	case NotifierTypeSlack:
+		return &SlackNotifier{
+			WebhookUrl: config.Url,
+			Channel:    config.Channel,
+			Username:   config.Username,
+			Client:     client,
+		}, nil
+	case NotifierTypeWebhook:
+		return &WebhookNotifier{
+			Url:     config.Url,
+			Headers: config.Headers,
+			Client:  client,
+		}, nil
+	}
+	return nil, NewErrorf("notifier type must be '%s' or '%s' but got '%s'", NotifierTypeSlack, NotifierTypeWebhook, config.Type)
+}
+
+func postJson(client *http.Client, url string, headers map[string]string, body interface{}) error {
+	d, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", url, bytes.NewReader(d))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return NewErrorf("%s responded with status %d", url, res.StatusCode)
+	}
+	return nil
+}
+
+// 任意のエンドポイントにRollOutEventをそのままJSONでPOSTする
+type WebhookNotifier struct {
+	Url     string
+	Headers map[string]string
+	Client  *http.Client
+}
+
+func (n *WebhookNotifier) Notify(event *RollOutEvent) error {
+	return postJson(n.Client, n.Url, n.Headers, event)
+}
+
+// SlackのIncoming Webhook
+type SlackNotifier struct {
+	WebhookUrl string
+	Channel    string
+	Username   string
+	Client     *http.Client
+}
+
+type slackMessage struct {
+	Channel     string            `json:"channel,omitempty"`
+	Username    string            `json:"username,omitempty"`
+	Text        string            `json:"text"`
+	Attachments []slackAttachment `json:"attachments,omitempty"`
+}
+
+type slackAttachment struct {
+	Color  string       `json:"color"`
+	Fields []slackField `json:"fields"`
+}
+
+type slackField struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+	Short bool   `json:"short"`
+}
+
+func slackText(event *RollOutEvent) (string, string) {
+	switch event.Phase {
+	case PhaseStarted:
+		return fmt.Sprintf(":rocket: roll out of `%s` has started", event.Service), "#439fe0"
+	case PhaseCanaryHealthy:
+		return fmt.Sprintf(":hatching_chick: canary task of `%s` is healthy", event.Service), "#439fe0"
+	case PhaseAwaitingApproval:
+		return fmt.Sprintf(":double_vertical_bar: roll out of `%s` is paused and waiting for approval", event.Service), "warning"
+	case PhasePrimaryUpdating:
+		return fmt.Sprintf(":arrows_counterclockwise: updating `%s` to the next task definition", event.Service), "warning"
+	case PhaseSucceeded:
+		return fmt.Sprintf(":white_check_mark: `%s` has been rolled out", event.Service), "good"
+	case PhaseRolledBack:
+		return fmt.Sprintf(":rewind: roll out of `%s` has failed and been rolled back", event.Service), "danger"
+	}
+	if event.ServiceIntact {
+		return fmt.Sprintf(":x: roll out of `%s` has failed but the service is not changed", event.Service), "danger"
+	}
+	return fmt.Sprintf(":fire: roll out of `%s` has failed and the service may be changed", event.Service), "danger"
+}
+
+func (n *SlackNotifier) Notify(event *RollOutEvent) error {
+	text, color := slackText(event)
+	fields := []slackField{
+		{Title: "Cluster", Value: event.Cluster, Short: true},
+		{Title: "Service", Value: event.Service, Short: true},
+	}
+	if event.TaskDefinitionArn != "" {
+		fields = append(fields, slackField{Title: "Task Definition", Value: event.TaskDefinitionArn})
+	}
+	if event.Error != "" {
+		fields = append(fields, slackField{Title: "Error", Value: event.Error})
+	}
+	return postJson(n.Client, n.WebhookUrl, nil, &slackMessage{
+		Channel:     n.Channel,
+		Username:    n.Username,
+		Text:        text,
+		Attachments: []slackAttachment{{Color: color, Fields: fields}},
+	})
+}
+
+// 通知の失敗や遅延でロールアウトを止めないように、通知は非同期にリトライしながら送る
+// 順番が入れ替わらないように送信先ごとにキューを持つ
+type NotifyDispatcher struct {
+	Notifiers []Notifier
+	queues    []chan RollOutEvent
+	event     RollOutEvent
+	clock     Clock
+	logger    log.Interface
+	mux       sync.Mutex
+	wg        sync.WaitGroup
+}
+
+const kNotifyQueueSize = 16
+
+func (envars *Envars) NewNotifyDispatcher() (*NotifyDispatcher, error) {
+	return envars.newNotifyDispatcher(nil)
+}
+
+// リトライの待ち時間とログはContextの時計とロガーを使う
+func (envars *Envars) newNotifyDispatcher(ctx *Context) (*NotifyDispatcher, error) {
+	var notifiers []Notifier
+	for _, v := range envars.Notifiers {
+		n, err := NewNotifier(v)
+		if err != nil {
+			return nil, err
+		}
+		notifiers = append(notifiers, n)
+	}
+	return newNotifyDispatcher(notifiers, RollOutEvent{
+		Cluster:       aws.StringValue(envars.Cluster),
+		Service:       aws.StringValue(envars.Service),
+		CanaryService: aws.StringValue(envars.CanaryService),
+	}, ctx.clock(), envars.logger(ctx)), nil
+}
+
+func NewNotifyDispatcher(notifiers []Notifier, event RollOutEvent) *NotifyDispatcher {
+	return newNotifyDispatcher(notifiers, event, systemClock{}, log.Log)
+}
+
+func newNotifyDispatcher(notifiers []Notifier, event RollOutEvent, clock Clock, logger log.Interface) *NotifyDispatcher {
+	d := &NotifyDispatcher{
+		Notifiers: notifiers,
+		event:     event,
+		clock:     clock,
+		logger:    logger,
+	}
+	for _, n := range notifiers {
+		q := make(chan RollOutEvent, kNotifyQueueSize)
+		d.queues = append(d.queues, q)
+		d.wg.Add(1)
+		go d.run(n, q)
+	}
+	return d
+}
+
+func (d *NotifyDispatcher) run(n Notifier, q chan RollOutEvent) {
+	defer d.wg.Done()
+	for event := range q {
+		var err error
+		for i := 0; i < kNotifyMaxAttempts; i++ {
+			if i > 0 {
+				<-d.clock.NewTimer(kNotifyRetryInterval).C
+			}
+			if err = n.Notify(&event); err == nil {
+				break
+			}
+			d.logger.WithFields(log.Fields{
+				"phase":   event.Phase,
+				"attempt": fmt.Sprintf("%d/%d", i+1, kNotifyMaxAttempts),
+			}).WithError(err).Warn("failed to send notification")
+		}
+		if err != nil {
+			d.logger.WithField("phase", event.Phase).WithError(err).Error("gave up sending notification")
+		}
+	}
+}
+
+func (d *NotifyDispatcher) SetTaskDefinitionArn(arn string) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	d.event.TaskDefinitionArn = arn
+}
+
+func (d *NotifyDispatcher) Notify(phase RollOutPhase) {
+	d.dispatch(phase, nil)
+}
+
+// ロールアウトの結果から succeeded, failed, rolled_back のいずれかを通知する
+func (d *NotifyDispatcher) NotifyResult(result *RollOutResult) {
+	phase := PhaseSucceeded
+	if result.RolledBack {
+		phase = PhaseRolledBack
+	} else if result.Error != nil {
+		phase = PhaseFailed
+	}
+	d.dispatch(phase, result)
+}
+
+func (d *NotifyDispatcher) dispatch(phase RollOutPhase, result *RollOutResult) {
+	d.mux.Lock()
+	event := d.event
+	d.mux.Unlock()
+	event.Phase = phase
+	event.Time = d.clock.Now()
+	event.ServiceIntact = true
+	if result != nil {
+		event.ServiceIntact = result.ServiceIntact
+		if result.Error != nil {
+			event.Error = result.Error.Error()
+		}
+	}
+	for _, q := range d.queues {
+		select {
+		case q <- event:
+		default:
+			d.logger.WithField("phase", phase).Warn("notification queue is full. notification is dropped")
+		}
+	}
+}
+
+// 以降の通知を受け付けずに、送信中の通知を最大timeoutまで待つ
+func (d *NotifyDispatcher) Close(timeout time.Duration) {
+	for _, q := range d.queues {
+		close(q)
+	}
+	done := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		d.logger.WithField("timeout", timeout.String()).Warn("some notifications haven't been sent within timeout")
+	}
+}
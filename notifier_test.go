@@ -0,0 +1,150 @@
+package cage
+
+import (
+	"encoding/json"
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+type webhookRecorder struct {
+	Events   []*RollOutEvent
+	Headers  []http.Header
+	Failures int
+	mux      sync.Mutex
+}
+
+func newWebhookServer(rec *webhookRecorder) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec.mux.Lock()
+		defer rec.mux.Unlock()
+		if rec.Failures > 0 {
+			rec.Failures--
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		event := &RollOutEvent{}
+		if err := json.NewDecoder(r.Body).Decode(event); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		rec.Events = append(rec.Events, event)
+		rec.Headers = append(rec.Headers, r.Header)
+	}))
+}
+
+func (rec *webhookRecorder) phases() []RollOutPhase {
+	rec.mux.Lock()
+	defer rec.mux.Unlock()
+	var ret []RollOutPhase
+	for _, v := range rec.Events {
+		ret = append(ret, v.Phase)
+	}
+	return ret
+}
+
+func TestEnvars_RollOut_Notify(t *testing.T) {
+	rec := &webhookRecorder{}
+	server := newWebhookServer(rec)
+	defer server.Close()
+	envars := DefaultEnvars()
+	envars.Notifiers = []*NotifierConfig{{
+		Type:    NotifierTypeWebhook,
+		Url:     server.URL,
+		Headers: map[string]string{"Authorization": "Bearer token"},
+	}}
+	_, ctx := envars.Setup(gomock.NewController(t), 2, "FARGATE")
+	result := envars.RollOut(ctx)
+	assert.Nil(t, result.Error)
+	assert.Equal(t, []RollOutPhase{
+		PhaseStarted, PhaseCanaryHealthy, PhasePrimaryUpdating, PhaseSucceeded,
+	}, rec.phases())
+	last := rec.Events[3]
+	assert.Equal(t, "cage-test", last.Cluster)
+	assert.Equal(t, "service", last.Service)
+	assert.NotEqual(t, "", last.TaskDefinitionArn)
+	assert.Equal(t, "Bearer token", rec.Headers[0].Get("Authorization"))
+}
+
+func TestEnvars_RollOut_NotifyFailed(t *testing.T) {
+	rec := &webhookRecorder{}
+	server := newWebhookServer(rec)
+	defer server.Close()
+	envars := DefaultEnvars()
+	envars.Notifiers = []*NotifierConfig{{Type: NotifierTypeWebhook, Url: server.URL}}
+	_, ctx := envars.Setup(gomock.NewController(t), 2, "FARGATE")
+	// 存在しないタスク定義で失敗させる
+	envars.TaskDefinitionArn = aws.String("arn://unknown")
+	result := envars.RollOut(ctx)
+	assert.NotNil(t, result.Error)
+	phases := rec.phases()
+	assert.Equal(t, PhaseFailed, phases[len(phases)-1])
+	assert.True(t, rec.Events[len(phases)-1].ServiceIntact)
+	assert.NotEqual(t, "", rec.Events[len(phases)-1].Error)
+}
+
+func TestEnvars_RollOut_NotifierDown(t *testing.T) {
+	// 通知先が落ちていてもロールアウトは成功する
+	rec := &webhookRecorder{Failures: 1000}
+	server := newWebhookServer(rec)
+	defer server.Close()
+	envars := DefaultEnvars()
+	envars.Notifiers = []*NotifierConfig{{Type: NotifierTypeSlack, Url: server.URL}}
+	_, ctx := envars.Setup(gomock.NewController(t), 2, "FARGATE")
+	result := envars.RollOut(ctx)
+	assert.Nil(t, result.Error)
+	assert.False(t, result.ServiceIntact)
+}
+
+func TestNotifyDispatcher_Retry(t *testing.T) {
+	rec := &webhookRecorder{Failures: 2}
+	server := newWebhookServer(rec)
+	defer server.Close()
+	n, _ := NewNotifier(&NotifierConfig{Type: NotifierTypeWebhook, Url: server.URL})
+	d := newNotifyDispatcher([]Notifier{n}, RollOutEvent{Service: "service"}, fakeClock{}, log.Log)
+	d.Notify(PhaseStarted)
+	d.Notify(PhaseCanaryHealthy)
+	d.Close(kNotifyWaitTimeout)
+	// 2回失敗しても3回目で送れる。順番は入れ替わらない
+	assert.Equal(t, []RollOutPhase{PhaseStarted, PhaseCanaryHealthy}, rec.phases())
+}
+
+func TestSlackNotifier(t *testing.T) {
+	var body slackMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+	}))
+	defer server.Close()
+	n, err := NewNotifier(&NotifierConfig{
+		Type:     NotifierTypeSlack,
+		Url:      server.URL,
+		Channel:  "#deploy",
+		Username: "cage",
+	})
+	assert.Nil(t, err)
+	err = n.Notify(&RollOutEvent{
+		Phase:         PhaseFailed,
+		Cluster:       "cluster",
+		Service:       "service",
+		ServiceIntact: true,
+		Error:         "canary task hasn't become to healthy",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "#deploy", body.Channel)
+	assert.Equal(t, "cage", body.Username)
+	assert.Contains(t, body.Text, "`service`")
+	assert.Equal(t, "danger", body.Attachments[0].Color)
+	assert.Equal(t, "canary task hasn't become to healthy", body.Attachments[0].Fields[2].Value)
+}
+
+func TestNewNotifier(t *testing.T) {
+	_, err := NewNotifier(&NotifierConfig{Type: "email", Url: "http://localhost"})
+	assert.NotNil(t, err)
+	_, err = NewNotifier(&NotifierConfig{Type: NotifierTypeSlack})
+	assert.NotNil(t, err)
+}
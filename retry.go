@@ -0,0 +1,170 @@
+package cage
+
+import (
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/google/uuid"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const MaxAttemptsKey = "CAGE_MAX_ATTEMPTS"
+const kDefaultMaxAttempts = 5
+
+// AWS APIのスロットリングや一時的な5xxエラーを指数バックオフとジッターでリトライする
+// セッションのRetryerとして使うので、リトライはSDKの中の1か所だけで行う
+type RetryPolicy struct {
+	// 最初の呼び出しを含めた最大試行回数
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+var kDefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: kDefaultMaxAttempts,
+	BaseDelay:   time.Duration(1) * time.Second,
+	MaxDelay:    time.Duration(20) * time.Second,
+}
+
+// テストで差し替える
+var jitter = rand.Float64
+
+func parseMaxAttempts(o *string) (int, error) {
+	if isEmpty(o) {
+		return kDefaultMaxAttempts, nil
+	}
+	n, err := strconv.Atoi(*o)
+	if err != nil || n < 1 {
+		return 0, NewErrorf("--maxAttempts [%s] must be a positive integer: '%s'", MaxAttemptsKey, *o)
+	}
+	return n, nil
+}
+
+func (envars *Envars) RetryPolicy() RetryPolicy {
+	p := kDefaultRetryPolicy
+	if n, err := parseMaxAttempts(envars.MaxAttempts); err == nil {
+		p.MaxAttempts = n
+	}
+	return p
+}
+
+// スロットリング、サーバーエラー、通信エラーはリトライする。それ以外は恒久的なエラー
+func IsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if reqErr, ok := err.(awserr.RequestFailure); ok && reqErr.StatusCode() >= 500 {
+		return true
+	}
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		return false
+	}
+	if request.IsErrorThrottle(err) {
+		return true
+	}
+	switch aerr.Code() {
+	case ecs.ErrCodeServerException, "InternalFailure", "InternalError", "ServiceUnavailable",
+		"RequestError", request.ErrCodeRead, request.ErrCodeResponseTimeout:
+		return true
+	}
+	return false
+}
+
+// attempt回目 (1始まり) の失敗の後に待つ時間。上限までの指数バックオフの範囲でランダムに待つ (full jitter)
+func (p RetryPolicy) Delay(attempt int) time.Duration {
+	d := p.MaxDelay
+	if attempt < 31 {
+		if o := p.BaseDelay << uint(attempt-1); o > 0 && o < d {
+			d = o
+		}
+	}
+	return time.Duration(jitter() * float64(d))
+}
+
+// 同じ入力で2回呼ぶと2回実行されるAPI。タスクやルールが二重にできるのでリトライしない
+// CreateServiceとCreateTaskSetは冪等性トークンを付けるのでリトライできる
+var kNonIdempotentOperations = map[string]bool{
+	ecs.ServiceName + ".RunTask":                 true,
+	ecs.ServiceName + ".StartTask":               true,
+	ecs.ServiceName + ".RegisterTaskDefinition":  true,
+	elbv2.ServiceName + ".CreateRule":            true,
+	codedeploy.ServiceName + ".CreateDeployment": true,
+}
+
+// RetryPolicyでリトライするSDKのRetryer
+type retryer struct {
+	policy RetryPolicy
+	logger log.Interface
+}
+
+func (r *retryer) MaxRetries() int {
+	return r.policy.MaxAttempts - 1
+}
+
+func (r *retryer) ShouldRetry(req *request.Request) bool {
+	if kNonIdempotentOperations[req.ClientInfo.ServiceName+"."+req.Operation.Name] {
+		return false
+	}
+	return IsRetryableError(req.Error)
+}
+
+func (r *retryer) RetryRules(req *request.Request) time.Duration {
+	delay := r.policy.Delay(req.RetryCount + 1)
+	r.logger.WithError(req.Error).WithFields(log.Fields{
+		"operation":   req.ClientInfo.ServiceID + "." + req.Operation.Name,
+		"attempt":     req.RetryCount + 1,
+		"maxAttempts": r.policy.MaxAttempts,
+		"delay":       delay.String(),
+	}).Warn("retrying AWS API call")
+	return delay
+}
+
+// リトライしても同じサービスが二重に作られないように、冪等性トークンを付けてから送る
+// 呼び出し元の入力は書き換えない
+var clientTokenHandler = request.NamedHandler{
+	Name: "cage.ClientTokenHandler",
+	Fn: func(req *request.Request) {
+		switch input := req.Params.(type) {
+		case *ecs.CreateServiceInput:
+			if input.ClientToken == nil {
+				copied := *input
+				copied.ClientToken = newClientToken()
+				req.Params = &copied
+			}
+		case *ecs.CreateTaskSetInput:
+			if input.ClientToken == nil {
+				copied := *input
+				copied.ClientToken = newClientToken()
+				req.Params = &copied
+			}
+		}
+	},
+}
+
+func newClientToken() *string {
+	return aws.String(strings.Replace(uuid.New().String(), "-", "", -1))
+}
+
+// セッションのSDKのリトライを envars のRetryPolicyに置き換えたコピーを返す
+// このセッションから作ったすべてのクライアントの呼び出しがリトライされる
+func (envars *Envars) RetrySession(ses *session.Session) *session.Session {
+	return retrySession(ses, envars.RetryPolicy(), envars.Logger())
+}
+
+func retrySession(ses *session.Session, policy RetryPolicy, logger log.Interface) *session.Session {
+	cfg := request.WithRetryer(aws.NewConfig(), &retryer{policy: policy, logger: logger})
+	// 他のハンドラーがリトライできると決めていても、冪等でないAPIはリトライしない
+	cfg.EnforceShouldRetryCheck = aws.Bool(true)
+	ret := ses.Copy(cfg)
+	ret.Handlers.Build.PushFrontNamed(clientTokenHandler)
+	return ret
+}
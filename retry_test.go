@@ -0,0 +1,124 @@
+package cage
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func throttlingError() error {
+	return awserr.New("ThrottlingException", "Rate exceeded", nil)
+}
+
+func TestIsRetryableError(t *testing.T) {
+	for _, v := range []error{
+		throttlingError(),
+		awserr.New("RequestLimitExceeded", "", nil),
+		awserr.New("ServerException", "", nil),
+		awserr.NewRequestFailure(awserr.New("InternalError", "", nil), 503, "req"),
+		awserr.New("RequestError", "send request failed", errors.New("connection reset")),
+	} {
+		assert.True(t, IsRetryableError(v), v.Error())
+	}
+	for _, v := range []error{
+		nil,
+		errors.New("error"),
+		awserr.New("ClientException", "", nil),
+		awserr.New("ServiceNotFoundException", "", nil),
+		awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", nil), 400, "req"),
+		awserr.New("ResourceNotReady", "exceeded wait attempts", nil),
+	} {
+		assert.False(t, IsRetryableError(v), "%v", v)
+	}
+}
+
+func TestRetryPolicy_Delay(t *testing.T) {
+	jitter = func() float64 { return 1 }
+	defer func() { jitter = rand.Float64 }()
+	p := RetryPolicy{MaxAttempts: 5, BaseDelay: time.Second, MaxDelay: 10 * time.Second}
+	assert.Equal(t, time.Second, p.Delay(1))
+	assert.Equal(t, 2*time.Second, p.Delay(2))
+	assert.Equal(t, 8*time.Second, p.Delay(4))
+	assert.Equal(t, 10*time.Second, p.Delay(5))
+	assert.Equal(t, 10*time.Second, p.Delay(100))
+	jitter = func() float64 { return 0.5 }
+	assert.Equal(t, 4*time.Second, p.Delay(4))
+}
+
+func TestEnvars_RetryPolicy(t *testing.T) {
+	envars := DefaultEnvars()
+	assert.Equal(t, kDefaultMaxAttempts, envars.RetryPolicy().MaxAttempts)
+	envars.MaxAttempts = aws.String("2")
+	assert.Equal(t, 2, envars.RetryPolicy().MaxAttempts)
+	for _, v := range []string{"0", "-1", "a"} {
+		envars.MaxAttempts = aws.String(v)
+		assert.NotNil(t, EnsureEnvars(envars), v)
+	}
+}
+
+// 操作ごとに最初の呼び出しだけ500を返すECS
+func newFlakyEcsServer(calls map[string][]string) *httptest.Server {
+	var mux sync.Mutex
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		op := r.Header.Get("X-Amz-Target")
+		op = op[strings.LastIndex(op, ".")+1:]
+		mux.Lock()
+		calls[op] = append(calls[op], string(body))
+		n := len(calls[op])
+		mux.Unlock()
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		if n == 1 {
+			w.WriteHeader(500)
+			_, _ = w.Write([]byte(`{"__type":"ServerException","message":"internal error"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+}
+
+func TestRetrySession(t *testing.T) {
+	calls := make(map[string][]string)
+	server := newFlakyEcsServer(calls)
+	defer server.Close()
+	ses, _ := session.NewSession(&aws.Config{
+		Region:      aws.String("us-west-2"),
+		Endpoint:    aws.String(server.URL),
+		Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+	})
+	api := ecs.New(retrySession(ses, RetryPolicy{MaxAttempts: 3}, log.Log))
+	// 読むだけの呼び出しはリトライする
+	_, err := api.DescribeServices(&ecs.DescribeServicesInput{Services: []*string{aws.String("service")}})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(calls["DescribeServices"]))
+	// 冪等でない呼び出しはリトライしない
+	_, err = api.RunTask(&ecs.RunTaskInput{TaskDefinition: aws.String("td")})
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, len(calls["RunTask"]))
+	// 冪等性トークンを付けてリトライする。呼び出し元の入力は書き換えない
+	input := &ecs.CreateServiceInput{ServiceName: aws.String("service")}
+	_, err = api.CreateService(input)
+	assert.Nil(t, err)
+	assert.Nil(t, input.ClientToken)
+	if assert.Equal(t, 2, len(calls["CreateService"])) {
+		var first, second ecs.CreateServiceInput
+		_ = json.Unmarshal([]byte(calls["CreateService"][0]), &first)
+		_ = json.Unmarshal([]byte(calls["CreateService"][1]), &second)
+		assert.Equal(t, 32, len(aws.StringValue(first.ClientToken)))
+		assert.Equal(t, first.ClientToken, second.ClientToken)
+	}
+}
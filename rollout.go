@@ -3,41 +3,142 @@ package cage
 import (
 	"encoding/base64"
 	"encoding/json"
-	"errors"
-	"fmt"
 	"github.com/apex/log"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/aws/aws-sdk-go/service/codedeploy/codedeployiface"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/aws/aws-sdk-go/service/servicediscovery/servicediscoveryiface"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"time"
 )
 
 type Context struct {
-	Ecs ecsiface.ECSAPI
-	Alb elbv2iface.ELBV2API
+	Ecs              ecsiface.ECSAPI
+	Alb              elbv2iface.ELBV2API
+	Ssm              ssmiface.SSMAPI
+	Secrets          secretsmanageriface.SecretsManagerAPI
+	Dynamo           dynamodbiface.DynamoDBAPI
+	CodeDeploy       codedeployiface.CodeDeployAPI
+	ServiceDiscovery servicediscoveryiface.ServiceDiscoveryAPI
+	Logs             cloudwatchlogsiface.CloudWatchLogsAPI
+	Tracer           *Tracer
+	GithubActions    *GithubActionsReporter
+	// 指定しなければパッケージの時計とapex/logのロガー
+	Clock  Clock
+	Logger log.Interface
+	Hooks  *Hooks
+	// approvalで承認を待つ。CLIでは端末のプロンプト
+	Approver Approver
 }
 
 type RollOutResult struct {
 	StartTime     time.Time
 	EndTime       time.Time
 	ServiceIntact bool
+	RolledBack    bool
 	Error         error
+	// 登録した次のタスク定義
+	TaskDefinitionArn *string
+	// ロールアウト前のサービスのタスク定義
+	PreviousTaskDefinitionArn *string
+	// 各フェーズに入った時刻
+	PhaseTimes map[RollOutPhase]time.Time
+	// カナリアタスクが健康になるまで待った時間
+	CanaryHealthWait time.Duration
+	// スモークテストの結果
+	SmokeChecks []*SmokeCheckResult
+	// 承認待ちの状態と止まっていた時間。approvalがなければ空
+	Approval     ApprovalState
+	ApprovalWait time.Duration
 }
 
 func (envars *Envars) RollOut(
 	ctx *Context,
 ) *RollOutResult {
+	clock := ctx.clock()
 	ret := &RollOutResult{
-		StartTime:     now(),
+		StartTime:     clock.Now(),
 		ServiceIntact: true,
+		PhaseTimes:    make(map[RollOutPhase]time.Time),
+	}
+	defer func() {
+		ctx.Hooks.result(ret)
+		ctx.GithubActions.finish(envars, ret)
+		envars.NewDeployStats(ret).PushIfConfigured(envars.PushgatewayUrl, envars.logger(ctx))
+	}()
+	span := ctx.Tracer.Start("rollout")
+	span.SetAttribute("ecs.cluster", *envars.Cluster)
+	span.SetAttribute("ecs.service", *envars.Service)
+	var phaseSpan *Span
+	defer func() {
+		phaseSpan.SetError(ret.Error)
+		phaseSpan.End()
+		span.SetError(ret.Error)
+		span.End()
+	}()
+	notifier, err := envars.newNotifyDispatcher(ctx)
+	if err != nil {
+		ret.EndTime = clock.Now()
+		ret.Error = err
+		return ret
+	}
+	defer notifier.Close(kNotifyWaitTimeout)
+	logger := envars.logger(ctx)
+	hooks := envars.newLifecycleHookRunner(ctx)
+	// ロールアウト中にハートビートに失敗したら、次の変更をする前に中止する
+	var lock *HeldLock
+	// カナリアが健康になった後とプライマリを更新する前のフックはフェーズに入るときに実行する
+	enter := func(phase RollOutPhase) error {
+		logger = envars.logger(ctx).WithField("phase", phase)
+		ret.PhaseTimes[phase] = clock.Now()
+		ctx.GithubActions.EnterPhase(phase)
+		ctx.Hooks.phase(phase)
+		notifier.Notify(phase)
+		phaseSpan.End()
+		phaseSpan = ctx.Tracer.Start(string(phase))
+		switch phase {
+		case PhaseCanaryHealthy:
+			return hooks.Run(HookPostCanaryHealthy)
+		case PhasePrimaryUpdating:
+			if err := lock.Err(); err != nil {
+				return err
+			}
+			return hooks.Run(HookPrePrimaryUpdate)
+		}
+		return nil
 	}
 	throw := func(err error) *RollOutResult {
-		ret.EndTime = now()
+		hooks.RunOnFailure(err)
+		ret.EndTime = clock.Now()
 		ret.Error = err
+		notifier.NotifyResult(ret)
 		return ret
 	}
+	locker, err := envars.NewLocker(ctx)
+	if err != nil {
+		return throw(err)
+	}
+	if locker != nil {
+		logger.Info("acquiring deployment lock...")
+		if lock, err = AcquireLock(locker, NewLockOwner(), kDefaultLockTtl/3, ctx.clock(), envars.logger(ctx)); err != nil {
+			logger.WithError(err).Error("failed to acquire deployment lock")
+			return throw(err)
+		}
+		defer func() {
+			if err := lock.Release(); err != nil {
+				logger.WithError(err).Error("failed to release deployment lock")
+			} else {
+				logger.Info("deployment lock released")
+			}
+		}()
+	}
+	_ = enter(PhaseStarted)
 	out, err := ctx.Ecs.DescribeServices(&ecs.DescribeServicesInput{
 		Cluster: envars.Cluster,
 		Services: []*string{
@@ -45,55 +146,163 @@ func (envars *Envars) RollOut(
 		},
 	})
 	if err != nil {
-		log.Errorf("failed to describe current service due to: %s", err.Error())
+		logger.WithError(err).Error("failed to describe current service")
+		return throw(err)
+	} else if len(out.Services) == 0 {
+		err := NewErrorf("failed to describe current service '%s': %s", *envars.Service, describeServicesFailure(out))
+		logger.WithError(err).Error("failed to describe current service")
 		return throw(err)
 	}
 	service := out.Services[0]
-	var (
-		targetGroupArn *string
-		targetPort     *int64
-	)
+	ret.PreviousTaskDefinitionArn = service.TaskDefinition
+	hooks.service = service
+	var loadBalancer *ecs.LoadBalancer
 	if len(service.LoadBalancers) > 0 {
-		targetGroupArn = service.LoadBalancers[0].TargetGroupArn
-		targetPort = service.LoadBalancers[0].ContainerPort
+		loadBalancer = service.LoadBalancers[0]
+	}
+	var existingCanary *ecs.Service
+	if !IsExternalService(service) {
+		logger.Info("checking if canary service is left...")
+		if existingCanary, err = envars.HandleExistingCanaryService(ctx); err != nil {
+			return throw(err)
+		}
+	}
+	registered, input, err := envars.loadNextTaskDefinition(ctx.Ecs)
+	if err != nil {
+		logger.WithError(err).Error("failed to load next task definition")
+		return throw(err)
 	}
-	log.Infof("ensuring next task definition...")
-	nextTaskDefinition, err := envars.CreateNextTaskDefinition(ctx.Ecs)
+	logger.Info("checking secrets referenced by next task definition...")
+	var containers []*ecs.ContainerDefinition
+	if registered != nil {
+		containers = registered.ContainerDefinitions
+	} else {
+		containers = input.ContainerDefinitions
+	}
+	if err := envars.EnsureSecretsExist(ctx, containers); err != nil {
+		logger.WithError(err).Error("pre-flight check failed")
+		return throw(err)
+	}
+	logger.Info("ensuring next task definition...")
+	nextTaskDefinition, err := registerNextTaskDefinition(ctx.Ecs, registered, input)
 	if err != nil {
-		log.Errorf("failed to register next task definition due to: %s", err)
+		logger.WithError(err).Error("failed to register next task definition")
+		return throw(err)
+	}
+	ret.TaskDefinitionArn = nextTaskDefinition.TaskDefinitionArn
+	hooks.nextTaskDefinition = nextTaskDefinition
+	notifier.SetTaskDefinitionArn(*nextTaskDefinition.TaskDefinitionArn)
+	logger = logger.WithField("taskDefinition", *nextTaskDefinition.TaskDefinitionArn)
+	if err := hooks.Run(HookPreCanary); err != nil {
 		return throw(err)
 	}
-	log.Infof("ensuring canary service '%s'...", *envars.CanaryService)
-	if err := envars.CreateCanaryService(ctx.Ecs, nextTaskDefinition.TaskDefinitionArn); err != nil {
-		log.Errorf("failed to create next service due to: %s", err)
+	if err := lock.Err(); err != nil {
 		return throw(err)
 	}
-	log.Infof("service '%s' ensured.", *envars.CanaryService)
-	if targetGroupArn != nil {
-		log.Infof("ensuring canary task to become healthy...")
-		if err := envars.EnsureTaskHealthy(ctx, targetGroupArn, targetPort); err != nil {
+	if envars.Migration != nil {
+		if err := envars.RunMigration(ctx, service, nextTaskDefinition); err != nil {
 			return throw(err)
 		}
-		log.Info("🤩 canary task is healthy!")
 	}
-	ret.ServiceIntact = false
-	log.Infof("updating '%s' 's task definition to '%s:%d'...", *envars.Service, *nextTaskDefinition.Family, *nextTaskDefinition.Revision)
-	if _, err := ctx.Ecs.UpdateService(&ecs.UpdateServiceInput{
-		Cluster:        envars.Cluster,
-		Service:        envars.Service,
-		TaskDefinition: nextTaskDefinition.TaskDefinitionArn,
-	}); err != nil {
+	if IsExternalService(service) {
+		if err := envars.RollOutWithTaskSet(ctx, service, nextTaskDefinition, enter, ret); err != nil {
+			return throw(err)
+		}
+		if err := hooks.Run(HookPostRollout); err != nil {
+			// タスクセットは昇格済みで元に戻せない
+			return throw(err)
+		}
+		logger.Info("🤗 service rolled out")
+		ret.EndTime = clock.Now()
+		notifier.NotifyResult(ret)
+		return ret
+	}
+	// カナリアを作り始めてからプライマリを更新する前に失敗したらカナリアサービスを消して元に戻す
+	// 残ったカナリアがあると次のロールアウトが onExistingCanary=abort で止まってしまう
+	abort := func(err error) *RollOutResult {
+		if canary, derr := envars.DescribeExistingCanaryService(ctx.Ecs); derr != nil {
+			logger.WithError(derr).Error("failed to describe canary service")
+		} else if canary != nil {
+			if derr := envars.DeleteExistingCanaryService(ctx, canary); derr != nil {
+				logger.WithError(derr).Error("failed to delete canary service")
+			}
+		}
 		return throw(err)
 	}
-	log.Infof("waiting for service '%s' to be stable...", *envars.Service)
-	if err := ctx.Ecs.WaitUntilServicesStable(&ecs.DescribeServicesInput{
-		Cluster:  envars.Cluster,
-		Services: []*string{envars.Service},
-	}); err != nil {
+	if err := lock.Err(); err != nil {
+		return throw(err)
+	}
+	logger.Info("ensuring canary service...")
+	if existingCanary != nil {
+		if err := envars.UpdateCanaryService(ctx, nextTaskDefinition.TaskDefinitionArn); err != nil {
+			logger.WithError(err).Error("failed to reuse existing canary service")
+			return abort(err)
+		}
+	} else if err := envars.CreateCanaryService(ctx, nextTaskDefinition.TaskDefinitionArn); err != nil {
+		logger.WithError(err).Error("failed to create canary service")
+		return abort(err)
+	}
+	logger.Info("canary service ensured")
+	healthWaitStart := clock.Now()
+	healthSpan := ctx.Tracer.Start("canary_health_check")
+	var canaryTarget *elbv2.TargetDescription
+	if loadBalancer != nil {
+		logger.Info("ensuring canary task to become healthy...")
+		if canaryTarget, err = envars.EnsureTaskHealthy(ctx, loadBalancer); err != nil {
+			healthSpan.SetError(err)
+			healthSpan.End()
+			return abort(err)
+		}
+		logger.Info("🤩 canary task is healthy!")
+	}
+	if err := envars.EnsureServiceDiscoveryHealthy(ctx); err != nil {
+		logger.WithError(err).Error("canary task hasn't become healthy in service discovery")
+		healthSpan.SetError(err)
+		healthSpan.End()
+		return abort(err)
+	}
+	healthSpan.End()
+	ret.CanaryHealthWait = clock.Now().Sub(healthWaitStart)
+	// 健康になった時点で通知してから、スモークテストと承認で確かめる
+	if err := enter(PhaseCanaryHealthy); err != nil {
+		return abort(err)
+	}
+	if err := envars.VerifyCanary(ctx, loadBalancer, canaryTarget, ret, enter); err != nil {
+		return abort(err)
+	}
+	if err := enter(PhasePrimaryUpdating); err != nil {
+		return abort(err)
+	}
+	ret.ServiceIntact = false
+	if IsCodeDeployService(service) {
+		logger.Info("deploying with CodeDeploy...")
+		if rolledBack, err := envars.DeployWithCodeDeploy(ctx, service, nextTaskDefinition.TaskDefinitionArn); err != nil {
+			ret.RolledBack = rolledBack
+			return throw(err)
+		}
+		logger.Info("🥴 deployment has succeeded!")
+	} else {
+		logger.Info("updating task definition of service...")
+		if _, err := ctx.Ecs.UpdateService(&ecs.UpdateServiceInput{
+			Cluster:        envars.Cluster,
+			Service:        envars.Service,
+			TaskDefinition: nextTaskDefinition.TaskDefinitionArn,
+		}); err != nil {
+			return throw(err)
+		}
+		logger.Info("waiting for service to be stable...")
+		if err := ctx.Ecs.WaitUntilServicesStable(&ecs.DescribeServicesInput{
+			Cluster:  envars.Cluster,
+			Services: []*string{envars.Service},
+		}); err != nil {
+			return throw(err)
+		}
+		logger.Info("🥴 service has become to be stable!")
+	}
+	if err := lock.Err(); err != nil {
 		return throw(err)
 	}
-	log.Infof("🥴 service '%s' has become to be stable!", *envars.Service)
-	log.Infof("deleting canary service '%s'...", *envars.CanaryService)
+	logger.Info("deleting canary service...")
 	if _, err := ctx.Ecs.DeleteService(&ecs.DeleteServiceInput{
 		Cluster: envars.Cluster,
 		Service: envars.CanaryService,
@@ -101,104 +310,314 @@ func (envars *Envars) RollOut(
 	}); err != nil {
 		return throw(err)
 	}
-	log.Infof("canary service '%s' has successfully deleted", *envars.CanaryService)
-	log.Infof("🤗 service '%s' rolled out to '%s:%d'", *envars.Service, *nextTaskDefinition.Family, *nextTaskDefinition.Revision)
-	ret.EndTime = now()
+	logger.Info("canary service has successfully deleted")
+	if err := hooks.Run(HookPostRollout); err != nil {
+		if !IsCodeDeployService(service) {
+			// CodeDeployのサービスはUpdateServiceでタスク定義を戻せない
+			if rerr := envars.Rollback(ctx, ret.PreviousTaskDefinitionArn); rerr != nil {
+				logger.WithError(rerr).Error("failed to roll back service")
+			} else {
+				ret.RolledBack = true
+			}
+		}
+		return throw(err)
+	}
+	logger.Info("🤗 service rolled out")
+	ret.EndTime = clock.Now()
+	notifier.NotifyResult(ret)
 	return ret
 }
 
-func (envars *Envars) EnsureTaskHealthy(
+// 健康になったカナリアタスクにスモークテストを送り、承認を待つ
+// 承認を待つ前に enter で awaiting_approval フェーズに入る
+// canaryRouteのルールはその間だけ作り、終わったら成功しても失敗しても消す
+func (envars *Envars) VerifyCanary(
 	ctx *Context,
-	tgArn *string,
-	targetPort *int64,
+	lb *ecs.LoadBalancer,
+	target *elbv2.TargetDescription,
+	ret *RollOutResult,
+	enter func(phase RollOutPhase) error,
 ) error {
-	var canaryTaskId *string
-	var canaryTaskArn *string
-	if o, err := ctx.Ecs.ListTasks(&ecs.ListTasksInput{
+	logger := envars.logger(ctx)
+	var route *CanaryRoute
+	if envars.CanaryRoute != nil {
+		if lb == nil || target == nil {
+			return NewErrorf("canaryRoute requires the canary task to be registered to a load balancer")
+		}
+		var err error
+		if route, err = envars.OpenCanaryRoute(ctx, lb.TargetGroupArn, target); err != nil {
+			return err
+		}
+		defer route.Close()
+	}
+	if envars.SmokeTests != nil {
+		logger.Info("running smoke tests against canary task...")
+		if err := envars.RunSmokeTests(ctx, target, route, ret); err != nil {
+			return err
+		}
+	}
+	if envars.Approval != nil {
+		// 止まっていることを通知やフックにも知らせてから待つ
+		if err := enter(PhaseAwaitingApproval); err != nil {
+			return err
+		}
+		if err := envars.WaitForApproval(ctx, route, ret); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (envars *Envars) EnsureTaskHealthy(
+	ctx *Context,
+	lb *ecs.LoadBalancer,
+) (*elbv2.TargetDescription, error) {
+	return envars.ensureTaskHealthy(ctx, &ecs.ListTasksInput{
 		Cluster:     envars.Cluster,
 		ServiceName: envars.CanaryService,
-	}); err != nil {
-		return err
+	}, lb)
+}
+
+func (envars *Envars) ensureTaskHealthy(
+	ctx *Context,
+	listInput *ecs.ListTasksInput,
+	lb *ecs.LoadBalancer,
+) (*elbv2.TargetDescription, error) {
+	tg, err := envars.DescribeTargetGroup(ctx, lb.TargetGroupArn)
+	if err != nil {
+		return nil, err
+	}
+	var canaryTaskArn *string
+	var target *elbv2.TargetDescription
+	if o, err := ctx.Ecs.ListTasks(listInput); err != nil {
+		return nil, err
+	} else if len(o.TaskArns) == 0 {
+		return nil, NewErrorf("no canary task found")
 	} else if o, err := ctx.Ecs.DescribeTasks(&ecs.DescribeTasksInput{
 		Cluster: envars.Cluster,
 		Tasks:   o.TaskArns,
 	}); err != nil {
-		return err
+		return nil, err
+	} else if target, err = envars.ResolveTarget(ctx, o.Tasks[0], lb, tg); err != nil {
+		envars.logger(ctx).WithError(err).Error("failed to resolve target of canary task")
+		return nil, err
 	} else {
 		canaryTaskArn = o.Tasks[0].TaskArn
-		launchType := o.Tasks[0].LaunchType
-		if launchType == nil {
-			errMsg := "launch type is nil"
-			log.Error(errMsg)
-			return errors.New(errMsg)
-		}
-		if *launchType == "FARGATE" {
-			for _, d := range o.Tasks[0].Attachments[0].Details {
-				switch *d.Name {
-				case "privateIPv4Address":
-					canaryTaskId = d.Value
-					break
-				}
-			}
-		} else if *launchType == "EC2" {
-			containerInstanceArn := o.Tasks[0].ContainerInstanceArn
-			if outputs, err := ctx.Ecs.DescribeContainerInstances(&ecs.DescribeContainerInstancesInput{
-				ContainerInstances: []*string{containerInstanceArn},
-			}); err != nil {
-				return err
-			} else {
-				canaryTaskId = outputs.ContainerInstances[0].Ec2InstanceId
-			}
-		} else {
-			errMsg := fmt.Sprintf("launch type is unknown (%s)", *launchType)
-			log.Error(errMsg)
-			return errors.New(errMsg)
-		}
 	}
-	log.Infof("checking canary task's health state...")
+	canaryTaskId := target.Id
+	targetPort := target.Port
+	logger := envars.logger(ctx).WithFields(log.Fields{
+		"task":        *canaryTaskArn,
+		"target":      *canaryTaskId,
+		"targetGroup": *tg.TargetGroupArn,
+	})
+	policy := kAlbHealthPolicy
+	if IsNetworkTargetGroup(tg) {
+		logger.WithField("protocol", *tg.Protocol).Info("target group is network load balancer's. waiting longer for the target to be registered")
+		policy = kNlbHealthPolicy
+	}
+	logger.Info("checking canary task's health state...")
 	var unusedCount = 0
+	var unavailableCount = 0
 	var initialized = false
 	var recentState *string
 	for {
-		<-newTimer(time.Duration(15) * time.Second).C
+		<-ctx.clock().NewTimer(policy.interval).C
 		if o, err := ctx.Alb.DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{
-			TargetGroupArn: tgArn,
-			Targets: []*elbv2.TargetDescription{{
-				Id:   canaryTaskId,
-				Port: targetPort,
-			}},
+			TargetGroupArn: tg.TargetGroupArn,
+			Targets:        []*elbv2.TargetDescription{target},
 		}); err != nil {
-			return err
+			return nil, err
 		} else {
 			recentState = GetTargetIsHealthy(o, canaryTaskId, targetPort)
 			if recentState == nil {
-				return NewErrorf("'%s' is not registered to target group '%s'", *canaryTaskId, *tgArn)
+				return nil, NewErrorf("'%s:%d' is not registered to target group '%s'", *canaryTaskId, *targetPort, *tg.TargetGroupArn)
 			}
-			log.Infof("canary task '%s' (%s) state is: %s", *canaryTaskArn, *canaryTaskId, *recentState)
+			logger.WithField("state", *recentState).Info("canary task's health state")
 			switch *recentState {
-			case "healthy":
-				return nil
-			case "initial":
+			case elbv2.TargetHealthStateEnumHealthy:
+				return target, nil
+			case elbv2.TargetHealthStateEnumInitial:
 				initialized = true
-				log.Infof("still checking state...")
+				logger.Info("still checking state...")
 				continue
-			case "unused":
-				// 20回以上=300秒間unusedになった場合はエラーにする
+			case elbv2.TargetHealthStateEnumUnused:
+				// ALBは20回=300秒、NLBは登録が遅いので40回=600秒以上unusedになった場合はエラーにする
 				unusedCount++
-				if !initialized && unusedCount < 20 {
+				if !initialized && unusedCount < policy.maxUnused {
+					continue
+				}
+			case elbv2.TargetHealthStateEnumUnavailable:
+				// ヘルスチェックが無効なターゲットグループではヘルス状態が得られない
+				if tg.HealthCheckEnabled != nil && !*tg.HealthCheckEnabled {
+					logger.Warn("health check of target group is disabled. canary task is regarded as healthy")
+					return target, nil
+				}
+				unavailableCount++
+				if unavailableCount < policy.maxUnavailable {
 					continue
 				}
 			default:
 			}
 		}
-		// unhealthy, draining, unused
-		return NewErrorf("canary task '%s' (%s) hasn't become to healthy. Recent state: %s", *canaryTaskArn, *canaryTaskId, *recentState)
+		// unhealthy, draining, unused, unavailable
+		return nil, NewErrorf("canary task '%s' (%s) hasn't become to healthy. Recent state: %s", *canaryTaskArn, *canaryTaskId, *recentState)
+	}
+}
+
+type targetHealthPolicy struct {
+	interval       time.Duration
+	maxUnused      int
+	maxUnavailable int
+}
+
+var kAlbHealthPolicy = targetHealthPolicy{
+	interval:       time.Duration(15) * time.Second,
+	maxUnused:      20,
+	maxUnavailable: 4,
+}
+
+var kNlbHealthPolicy = targetHealthPolicy{
+	interval:       time.Duration(15) * time.Second,
+	maxUnused:      40,
+	maxUnavailable: 8,
+}
+
+// NLBのターゲットグループはTCP/UDP/TLSのいずれか
+func IsNetworkTargetGroup(tg *elbv2.TargetGroup) bool {
+	switch aws.StringValue(tg.Protocol) {
+	case elbv2.ProtocolEnumTcp, elbv2.ProtocolEnumUdp, elbv2.ProtocolEnumTls, elbv2.ProtocolEnumTcpUdp:
+		return true
+	}
+	return false
+}
+
+func (envars *Envars) DescribeTargetGroup(ctx *Context, tgArn *string) (*elbv2.TargetGroup, error) {
+	o, err := ctx.Alb.DescribeTargetGroups(&elbv2.DescribeTargetGroupsInput{
+		TargetGroupArns: []*string{tgArn},
+	})
+	if err != nil {
+		envars.logger(ctx).WithField("targetGroup", *tgArn).WithError(err).Error("failed to describe target group")
+		return nil, err
+	} else if len(o.TargetGroups) == 0 {
+		return nil, NewErrorf("target group '%s' not found", *tgArn)
+	}
+	return o.TargetGroups[0], nil
+}
+
+// ターゲットグループに登録されるのはターゲットタイプとネットワークモードによって異なる
+// ip: awsvpcのENIのプライベートIPとコンテナポート (FARGATEでもEC2でも)
+// instance: EC2インスタンスIDとホストポート (bridgeは動的ポートなのでnetworkBindingsから探す)
+func (envars *Envars) ResolveTarget(
+	ctx *Context,
+	task *ecs.Task,
+	lb *ecs.LoadBalancer,
+	tg *elbv2.TargetGroup,
+) (*elbv2.TargetDescription, error) {
+	targetType := aws.StringValue(tg.TargetType)
+	if targetType == "" {
+		targetType = elbv2.TargetTypeEnumInstance
+	}
+	td, err := ctx.Ecs.DescribeTaskDefinition(&ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: task.TaskDefinitionArn,
+	})
+	if err != nil {
+		return nil, err
+	}
+	networkMode := aws.StringValue(td.TaskDefinition.NetworkMode)
+	if networkMode == "" {
+		// 指定がなければbridge
+		networkMode = ecs.NetworkModeBridge
+	}
+	envars.logger(ctx).WithFields(log.Fields{
+		"task":        *task.TaskArn,
+		"launchType":  aws.StringValue(ResolveLaunchType(task)),
+		"networkMode": networkMode,
+		"targetType":  targetType,
+	}).Info("resolving target of canary task")
+	switch targetType {
+	case elbv2.TargetTypeEnumIp:
+		if networkMode != ecs.NetworkModeAwsvpc {
+			return nil, NewErrorf("target type 'ip' requires network mode 'awsvpc' but task uses '%s'", networkMode)
+		}
+		for _, a := range task.Attachments {
+			for _, d := range a.Details {
+				if aws.StringValue(d.Name) == "privateIPv4Address" {
+					return &elbv2.TargetDescription{
+						Id:   d.Value,
+						Port: lb.ContainerPort,
+					}, nil
+				}
+			}
+		}
+		return nil, NewErrorf("private ip address of task '%s' not found", *task.TaskArn)
+	case elbv2.TargetTypeEnumInstance:
+		if networkMode == ecs.NetworkModeAwsvpc {
+			return nil, NewErrorf("target type 'instance' can't be used with network mode 'awsvpc'")
+		}
+		if task.ContainerInstanceArn == nil {
+			return nil, NewErrorf("task '%s' has no container instance", *task.TaskArn)
+		}
+		hostPort := findHostPort(task, lb)
+		if hostPort == nil && networkMode == ecs.NetworkModeHost {
+			hostPort = lb.ContainerPort
+		}
+		if hostPort == nil {
+			return nil, NewErrorf("host port for container port %d of task '%s' not found", aws.Int64Value(lb.ContainerPort), *task.TaskArn)
+		}
+		o, err := ctx.Ecs.DescribeContainerInstances(&ecs.DescribeContainerInstancesInput{
+			Cluster:            envars.Cluster,
+			ContainerInstances: []*string{task.ContainerInstanceArn},
+		})
+		if err != nil {
+			return nil, err
+		} else if len(o.ContainerInstances) == 0 {
+			return nil, NewErrorf("container instance '%s' not found", *task.ContainerInstanceArn)
+		}
+		return &elbv2.TargetDescription{
+			Id:   o.ContainerInstances[0].Ec2InstanceId,
+			Port: hostPort,
+		}, nil
+	}
+	return nil, NewErrorf("target type '%s' is not supported", targetType)
+}
+
+func findHostPort(task *ecs.Task, lb *ecs.LoadBalancer) *int64 {
+	for _, c := range task.Containers {
+		if !isEmpty(lb.ContainerName) && aws.StringValue(c.Name) != *lb.ContainerName {
+			continue
+		}
+		for _, b := range c.NetworkBindings {
+			if aws.Int64Value(b.ContainerPort) == aws.Int64Value(lb.ContainerPort) && b.HostPort != nil {
+				return b.HostPort
+			}
+		}
+	}
+	return nil
+}
+
+// キャパシティプロバイダで起動したタスクはlaunchTypeが空のことがあるので
+// キャパシティプロバイダ名とコンテナインスタンスの有無から判断する
+func ResolveLaunchType(task *ecs.Task) *string {
+	switch aws.StringValue(task.LaunchType) {
+	case ecs.LaunchTypeFargate, ecs.LaunchTypeEc2:
+		return task.LaunchType
+	}
+	switch aws.StringValue(task.CapacityProviderName) {
+	case "":
+	case "FARGATE", "FARGATE_SPOT":
+		return aws.String(ecs.LaunchTypeFargate)
+	default:
+		return aws.String(ecs.LaunchTypeEc2)
+	}
+	if task.ContainerInstanceArn != nil {
+		return aws.String(ecs.LaunchTypeEc2)
 	}
+	return task.LaunchType
 }
 
 func GetTargetIsHealthy(o *elbv2.DescribeTargetHealthOutput, targetId *string, targetPort *int64) *string {
 	for _, desc := range o.TargetHealthDescriptions {
-		log.Debugf("%+v", desc)
 		if *desc.Target.Id == *targetId && *desc.Target.Port == *targetPort {
 			return desc.TargetHealth.State
 		}
@@ -207,30 +626,44 @@ func GetTargetIsHealthy(o *elbv2.DescribeTargetHealthOutput, targetId *string, t
 }
 
 func (envars *Envars) CreateNextTaskDefinition(awsEcs ecsiface.ECSAPI) (*ecs.TaskDefinition, error) {
+	registered, input, err := envars.loadNextTaskDefinition(awsEcs)
+	if err != nil {
+		return nil, err
+	}
+	return registerNextTaskDefinition(awsEcs, registered, input)
+}
+
+// ARNが指定されていれば登録済みのタスク定義を、そうでなければこれから登録するタスク定義を返す
+func (envars *Envars) loadNextTaskDefinition(awsEcs ecsiface.ECSAPI) (*ecs.TaskDefinition, *ecs.RegisterTaskDefinitionInput, error) {
 	if !isEmpty(envars.TaskDefinitionArn) {
 		o, err := awsEcs.DescribeTaskDefinition(&ecs.DescribeTaskDefinitionInput{
 			TaskDefinition: envars.TaskDefinitionArn,
 		})
 		if err != nil {
-			log.Errorf(
-				"failed to describe next task definition '%s' due to: %s",
-				*envars.TaskDefinitionArn, err,
-			)
-			return nil, err
+			return nil, nil, NewErrorf("failed to describe next task definition '%s' due to: %s", *envars.TaskDefinitionArn, err)
 		}
-		return o.TaskDefinition, nil
+		return o.TaskDefinition, nil, nil
 	}
 	data, err := base64.StdEncoding.DecodeString(*envars.TaskDefinitionBase64)
 	if err != nil {
-		log.Errorf("failed to decode task definition base64 due to :%s", err)
-		return nil, err
+		return nil, nil, NewErrorf("failed to decode task definition base64 due to: %s", err)
 	}
 	td := &ecs.RegisterTaskDefinitionInput{}
 	if err := json.Unmarshal(data, td); err != nil {
-		log.Errorf("failed to unmarshal task definition due to: %s", err)
-		return nil, err
+		return nil, nil, NewErrorf("failed to unmarshal task definition due to: %s", err)
 	}
-	if out, err := awsEcs.RegisterTaskDefinition(td); err != nil {
+	return nil, td, nil
+}
+
+func registerNextTaskDefinition(
+	awsEcs ecsiface.ECSAPI,
+	registered *ecs.TaskDefinition,
+	input *ecs.RegisterTaskDefinitionInput,
+) (*ecs.TaskDefinition, error) {
+	if registered != nil {
+		return registered, nil
+	}
+	if out, err := awsEcs.RegisterTaskDefinition(input); err != nil {
 		return nil, err
 	} else {
 		return out.TaskDefinition, nil
@@ -238,23 +671,30 @@ func (envars *Envars) CreateNextTaskDefinition(awsEcs ecsiface.ECSAPI) (*ecs.Tas
 }
 
 func (envars *Envars) CreateCanaryService(
-	awsEcs ecsiface.ECSAPI,
+	ctx *Context,
 	nextTaskDefinitionArn *string,
 ) error {
+	awsEcs := ctx.Ecs
+	logger := envars.logger(ctx).WithField("taskDefinition", aws.StringValue(nextTaskDefinitionArn))
 	service := &ecs.CreateServiceInput{}
 	if envars.ServiceDefinitionBase64 == nil {
 		// サービス定義が与えられなかった場合はタスク定義と名前だけ変えたservice-currentのレプリカを作成する
-		log.Infof("nextServiceDefinitionBase64 not provided. try to create replica service")
+		logger.Info("nextServiceDefinitionBase64 not provided. try to create replica service")
 		out, err := awsEcs.DescribeServices(&ecs.DescribeServicesInput{
 			Cluster:  envars.Cluster,
 			Services: []*string{envars.Service},
 		})
-		if len(out.Failures) > 0 || err != nil {
-			log.Errorf("failed to describe current service due to: %s", err)
+		if err != nil {
+			logger.WithError(err).Error("failed to describe current service")
+			return err
+		} else if len(out.Services) == 0 {
+			err := NewErrorf("failed to describe current service '%s': %s", *envars.Service, describeServicesFailure(out))
+			logger.WithError(err).Error("failed to describe current service")
 			return err
 		}
 		s := out.Services[0]
 		service = &ecs.CreateServiceInput{
+			CapacityProviderStrategy:      s.CapacityProviderStrategy,
 			Cluster:                       envars.Cluster,
 			DeploymentConfiguration:       s.DeploymentConfiguration,
 			DesiredCount:                  aws.Int64(1),
@@ -267,38 +707,51 @@ func (envars *Envars) CreateCanaryService(
 			PlatformVersion:               s.PlatformVersion,
 			SchedulingStrategy:            s.SchedulingStrategy,
 			ServiceName:                   envars.CanaryService,
-			ServiceRegistries:             s.ServiceRegistries,
+			ServiceRegistries:             envars.canaryServiceRegistries(s.ServiceRegistries),
 			TaskDefinition:                nextTaskDefinitionArn,
 		}
+		if len(s.CapacityProviderStrategy) > 0 {
+			// capacityProviderStrategyとlaunchTypeは同時に指定できない
+			service.LaunchType = nil
+		}
 	} else {
 		data, err := base64.StdEncoding.DecodeString(*envars.ServiceDefinitionBase64)
 		if err != nil {
-			log.Errorf("failed to decode service definition base64 due to : %s", err)
+			logger.WithError(err).Error("failed to decode service definition base64")
 			return err
 		}
 		if err := json.Unmarshal(data, service); err != nil {
-			log.Errorf("failed to unmarshal service definition base64 due to: %s", err)
+			logger.WithError(err).Error("failed to unmarshal service definition base64")
 			return err
 		}
 		service.ServiceName = envars.CanaryService
 		service.TaskDefinition = nextTaskDefinitionArn
+		service.ServiceRegistries = envars.canaryServiceRegistries(service.ServiceRegistries)
 		*service.DesiredCount = 1
 	}
-	log.Infof("creating canary service '%s' with desiredCount=1", *envars.CanaryService)
+	logger.Info("creating canary service with desiredCount=1")
 	if _, err := awsEcs.CreateService(service); err != nil {
-		log.Errorf("failed to create canary service due to: %s", err)
+		logger.WithError(err).Error("failed to create canary service")
 		return err
 	}
-	log.Infof("standing up for 10 seconds for '%s' become to be ready...", *service.ServiceName)
-	<-newTimer(time.Duration(10) * time.Second).C
-	log.Infof("waiting for service '%s' to become STABLE", *envars.CanaryService)
+	logger.Info("standing up for 10 seconds for canary service to become ready...")
+	<-ctx.clock().NewTimer(time.Duration(10) * time.Second).C
+	logger.Info("waiting for canary service to become STABLE")
 	if err := awsEcs.WaitUntilServicesStable(&ecs.DescribeServicesInput{
 		Cluster:  envars.Cluster,
 		Services: []*string{envars.CanaryService},
 	}); err != nil {
-		log.Errorf("'%s' hasn't reached STABLE state within maximum attempt windows due to: %s", err)
+		logger.WithError(err).Error("canary service hasn't reached STABLE state within maximum attempt windows")
 		return err
 	}
-	log.Infof("service '%s' has reached STABLE state", *envars.CanaryService)
+	logger.Info("canary service has reached STABLE state")
 	return nil
 }
+
+// DescribeServicesがサービスを返さなかった理由。見つからないサービスはFailuresに入る
+func describeServicesFailure(out *ecs.DescribeServicesOutput) string {
+	if len(out.Failures) > 0 {
+		return aws.StringValue(out.Failures[0].Reason)
+	}
+	return "service not found"
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"io/ioutil"
 	"testing"
+	"time"
 )
 
 func DefaultEnvars() *Envars {
@@ -28,6 +29,19 @@ func DefaultEnvars() *Envars {
 	}
 }
 
+// タイマーがすぐに発火する時計。待ち時間をなくすだけで時刻は進めない
+type fakeClock struct{}
+
+func (fakeClock) Now() time.Time {
+	return time.Now()
+}
+
+func (fakeClock) NewTimer(d time.Duration) *time.Timer {
+	ch := make(chan time.Time, 1)
+	ch <- time.Now()
+	return &time.Timer{C: ch}
+}
+
 func (envars *Envars) Setup(ctrl *gomock.Controller, currentTaskCount int64, launchType string) (*test.MockContext, *Context) {
 	ecsMock := mock_ecs.NewMockECSAPI(ctrl)
 	albMock := mock_elbv2.NewMockELBV2API(ctrl)
@@ -37,7 +51,9 @@ func (envars *Envars) Setup(ctrl *gomock.Controller, currentTaskCount int64, lau
 	ecsMock.EXPECT().DeleteService(gomock.Any()).DoAndReturn(mocker.DeleteService).AnyTimes()
 	ecsMock.EXPECT().StartTask(gomock.Any()).DoAndReturn(mocker.StartTask).AnyTimes()
 	ecsMock.EXPECT().StopTask(gomock.Any()).DoAndReturn(mocker.StopTask).AnyTimes()
+	ecsMock.EXPECT().RunTask(gomock.Any()).DoAndReturn(mocker.RunTask).AnyTimes()
 	ecsMock.EXPECT().RegisterTaskDefinition(gomock.Any()).DoAndReturn(mocker.RegisterTaskDefinition).AnyTimes()
+	ecsMock.EXPECT().DescribeTaskDefinition(gomock.Any()).DoAndReturn(mocker.DescribeTaskDefinition).AnyTimes()
 	ecsMock.EXPECT().WaitUntilServicesStable(gomock.Any()).DoAndReturn(mocker.WaitUntilServicesStable).AnyTimes()
 	ecsMock.EXPECT().WaitUntilServicesInactive(gomock.Any()).DoAndReturn(mocker.WaitUntilServicesInactive).AnyTimes()
 	ecsMock.EXPECT().DescribeServices(gomock.Any()).DoAndReturn(mocker.DescribeServices).AnyTimes()
@@ -46,11 +62,26 @@ func (envars *Envars) Setup(ctrl *gomock.Controller, currentTaskCount int64, lau
 	ecsMock.EXPECT().WaitUntilTasksStopped(gomock.Any()).DoAndReturn(mocker.WaitUntilTasksStopped).AnyTimes()
 	ecsMock.EXPECT().ListTasks(gomock.Any()).DoAndReturn(mocker.ListTasks).AnyTimes()
 	ecsMock.EXPECT().DescribeContainerInstances(gomock.Any()).DoAndReturn(mocker.DescribeContainerInstances).AnyTimes()
+	ecsMock.EXPECT().TagResource(gomock.Any()).DoAndReturn(mocker.TagResource).AnyTimes()
+	ecsMock.EXPECT().UntagResource(gomock.Any()).DoAndReturn(mocker.UntagResource).AnyTimes()
+	ecsMock.EXPECT().ListTagsForResource(gomock.Any()).DoAndReturn(mocker.ListTagsForResource).AnyTimes()
+	ecsMock.EXPECT().CreateTaskSet(gomock.Any()).DoAndReturn(mocker.CreateTaskSet).AnyTimes()
+	ecsMock.EXPECT().UpdateTaskSet(gomock.Any()).DoAndReturn(mocker.UpdateTaskSet).AnyTimes()
+	ecsMock.EXPECT().DescribeTaskSets(gomock.Any()).DoAndReturn(mocker.DescribeTaskSets).AnyTimes()
+	ecsMock.EXPECT().UpdateServicePrimaryTaskSet(gomock.Any()).DoAndReturn(mocker.UpdateServicePrimaryTaskSet).AnyTimes()
+	ecsMock.EXPECT().DeleteTaskSet(gomock.Any()).DoAndReturn(mocker.DeleteTaskSet).AnyTimes()
 	albMock.EXPECT().DescribeTargetGroups(gomock.Any()).DoAndReturn(mocker.DescribeTargetGroups).AnyTimes()
 	albMock.EXPECT().DescribeTargetHealth(gomock.Any()).DoAndReturn(mocker.DescribeTargetHealth).AnyTimes()
 	albMock.EXPECT().DescribeTargetGroupAttributes(gomock.Any()).DoAndReturn(mocker.DescribeTargetGroupAttibutes).AnyTimes()
+	albMock.EXPECT().RegisterTargets(gomock.Any()).DoAndReturn(mocker.RegisterTargets).AnyTimes()
+	albMock.EXPECT().DeregisterTargets(gomock.Any()).DoAndReturn(mocker.DeregisterTargets).AnyTimes()
+	albMock.EXPECT().CreateRule(gomock.Any()).DoAndReturn(mocker.CreateRule).AnyTimes()
+	albMock.EXPECT().DeleteRule(gomock.Any()).DoAndReturn(mocker.DeleteRule).AnyTimes()
+	albMock.EXPECT().DescribeRules(gomock.Any()).DoAndReturn(mocker.DescribeRules).AnyTimes()
+	albMock.EXPECT().CreateTargetGroup(gomock.Any()).DoAndReturn(mocker.CreateTargetGroup).AnyTimes()
+	albMock.EXPECT().DeleteTargetGroup(gomock.Any()).DoAndReturn(mocker.DeleteTargetGroup).AnyTimes()
 	o, _ := base64.StdEncoding.DecodeString(*envars.TaskDefinitionBase64)
-	var register *ecs.RegisterTaskDefinitionInput
+	register := &ecs.RegisterTaskDefinitionInput{}
 	_ = json.Unmarshal(o, register)
 	td, _ := mocker.RegisterTaskDefinition(register)
 	a := &ecs.CreateServiceInput{
@@ -68,15 +99,14 @@ func (envars *Envars) Setup(ctrl *gomock.Controller, currentTaskCount int64, lau
 	}
 	_, _ = mocker.CreateService(a)
 	return mocker, &Context{
-		Ecs: ecsMock,
-		Alb: albMock,
+		Ecs:   ecsMock,
+		Alb:   albMock,
+		Clock: fakeClock{},
 	}
 }
 
 func TestEnvars_RollOut(t *testing.T) {
 	log.SetLevel(log.DebugLevel)
-	newTimer = fakeTimer
-	defer recoverTimer()
 	for _, v := range []int64{1, 2, 15} {
 		log.Info("====")
 		envars := DefaultEnvars()
@@ -101,8 +131,6 @@ func TestEnvars_RollOut(t *testing.T) {
 func TestEnvars_StartGradualRollOut2(t *testing.T) {
 	// service definitionのjsonから読み込む
 	log.SetLevel(log.InfoLevel)
-	newTimer = fakeTimer
-	defer recoverTimer()
 	envars := DefaultEnvars()
 	d, _ := ioutil.ReadFile("fixtures/service.json")
 	envars.ServiceDefinitionBase64 = aws.String(base64.StdEncoding.EncodeToString(d))
@@ -119,14 +147,13 @@ func TestEnvars_StartGradualRollOut2(t *testing.T) {
 
 func TestEnvars_RollOut2(t *testing.T) {
 	// canary taskがtgに登録されるまで少し待つ
-	newTimer = fakeTimer
-	defer recoverTimer()
 	envars := DefaultEnvars()
 	d, _ := ioutil.ReadFile("fixtures/service.json")
 	envars.ServiceDefinitionBase64 = aws.String(base64.StdEncoding.EncodeToString(d))
 	ctrl := gomock.NewController(t)
 	mocker, ctx := envars.Setup(ctrl, 2, "FARGATE")
 	albMock := mock_elbv2.NewMockELBV2API(ctrl)
+	albMock.EXPECT().DescribeTargetGroups(gomock.Any()).DoAndReturn(mocker.DescribeTargetGroups).AnyTimes()
 	gomock.InOrder(
 		albMock.EXPECT().DescribeTargetHealth(gomock.Any()).Return(&elbv2.DescribeTargetHealthOutput{
 			TargetHealthDescriptions: []*elbv2.TargetHealthDescription{{
@@ -150,14 +177,13 @@ func TestEnvars_RollOut2(t *testing.T) {
 }
 func TestEnvars_RollOut3(t *testing.T) {
 	// canary taskがtgに登録されない場合は打ち切る
-	newTimer = fakeTimer
-	defer recoverTimer()
 	envars := DefaultEnvars()
 	d, _ := ioutil.ReadFile("fixtures/service.json")
 	envars.ServiceDefinitionBase64 = aws.String(base64.StdEncoding.EncodeToString(d))
 	ctrl := gomock.NewController(t)
-	_, ctx := envars.Setup(ctrl, 2, "FARGATE")
+	mocker, ctx := envars.Setup(ctrl, 2, "FARGATE")
 	albMock := mock_elbv2.NewMockELBV2API(ctrl)
+	albMock.EXPECT().DescribeTargetGroups(gomock.Any()).DoAndReturn(mocker.DescribeTargetGroups).AnyTimes()
 	albMock.EXPECT().DescribeTargetHealth(gomock.Any()).Return(&elbv2.DescribeTargetHealthOutput{
 		TargetHealthDescriptions: []*elbv2.TargetHealthDescription{{
 			Target: &elbv2.TargetDescription{
@@ -182,7 +208,37 @@ func TestEnvars_RollOut3(t *testing.T) {
 	ctx.Alb = albMock
 	result := envars.RollOut(ctx)
 	assert.NotNil(t, result.Error)
+	// 次のロールアウトが止まらないようにカナリアを消す
+	_, ok := mocker.GetService(*envars.CanaryService)
+	assert.False(t, ok)
+	assert.True(t, result.ServiceIntact)
+}
+
+func TestEnvars_CreateCanaryService_Failures(t *testing.T) {
+	envars := DefaultEnvars()
+	ctrl := gomock.NewController(t)
+	e := mock_ecs.NewMockECSAPI(ctrl)
+	e.EXPECT().DescribeServices(gomock.Any()).Return(&ecs.DescribeServicesOutput{
+		Failures: []*ecs.Failure{{Arn: aws.String("service"), Reason: aws.String("MISSING")}},
+	}, nil)
+	err := envars.CreateCanaryService(&Context{Ecs: e}, aws.String("td"))
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "failed to describe current service 'service': MISSING", err.Error())
+	}
+}
+
+func TestEnvars_RollOut_MissingService(t *testing.T) {
+	envars := DefaultEnvars()
+	ctrl := gomock.NewController(t)
+	_, ctx := envars.Setup(ctrl, 2, "FARGATE")
+	envars.Service = aws.String("missing")
+	result := envars.RollOut(ctx)
+	if assert.NotNil(t, result.Error) {
+		assert.Equal(t, "failed to describe current service 'missing': service not found", result.Error.Error())
+	}
+	assert.True(t, result.ServiceIntact)
 }
+
 func TestEnvars_StartGradualRollOut5(t *testing.T) {
 	// lbがないサービスの場合もロールアウトする
 	envars := DefaultEnvars()
@@ -190,8 +246,6 @@ func TestEnvars_StartGradualRollOut5(t *testing.T) {
 	input := &ecs.CreateServiceInput{}
 	_ = json.Unmarshal(d, input)
 	input.LoadBalancers = nil
-	newTimer = fakeTimer
-	defer recoverTimer()
 	o, _ := json.Marshal(input)
 	envars.ServiceDefinitionBase64 = aws.String(base64.StdEncoding.EncodeToString(o))
 	ctrl := gomock.NewController(t)
@@ -204,8 +258,6 @@ func TestEnvars_StartGradualRollOut5(t *testing.T) {
 }
 func TestEnvars_RollOut_EC2(t *testing.T) {
 	log.SetLevel(log.DebugLevel)
-	newTimer = fakeTimer
-	defer recoverTimer()
 	for _, v := range []int64{1, 2, 15} {
 		log.Info("====")
 		envars := DefaultEnvars()
@@ -263,3 +315,179 @@ func TestEnvars_CreateNextTaskDefinition2(t *testing.T) {
 	}
 	assert.Equal(t, "arn://next", *o.TaskDefinitionArn)
 }
+
+func TestEnvars_RollOut_CapacityProvider(t *testing.T) {
+	// capacityProviderStrategyを使うサービスもカナリアに引き継いでロールアウトする
+	for _, provider := range []string{"FARGATE_SPOT", "asg-provider"} {
+		envars := DefaultEnvars()
+		ctrl := gomock.NewController(t)
+		mctx, ctx := envars.Setup(ctrl, 2, "FARGATE")
+		s, _ := mctx.GetService(*envars.Service)
+		s.LaunchType = nil
+		s.CapacityProviderStrategy = []*ecs.CapacityProviderStrategyItem{{
+			CapacityProvider: aws.String(provider),
+			Weight:           aws.Int64(1),
+		}}
+		if err := envars.CreateCanaryService(ctx, s.TaskDefinition); err != nil {
+			t.Fatalf("%s", err)
+		}
+		canary, _ := mctx.GetService(*envars.CanaryService)
+		assert.Nil(t, canary.LaunchType)
+		assert.Equal(t, provider, *canary.CapacityProviderStrategy[0].CapacityProvider)
+		if _, err := envars.EnsureTaskHealthy(ctx, s.LoadBalancers[0]); err != nil {
+			t.Fatalf("%s", err)
+		}
+		_, _ = mctx.DeleteService(&ecs.DeleteServiceInput{Service: envars.CanaryService})
+		result := envars.RollOut(ctx)
+		if result.Error != nil {
+			t.Fatalf("%s", result.Error)
+		}
+		assert.False(t, result.ServiceIntact)
+	}
+}
+
+func withNetworkMode(envars *Envars, networkMode string) {
+	d, _ := base64.StdEncoding.DecodeString(*envars.TaskDefinitionBase64)
+	td := &ecs.RegisterTaskDefinitionInput{}
+	_ = json.Unmarshal(d, td)
+	td.NetworkMode = aws.String(networkMode)
+	o, _ := json.Marshal(td)
+	envars.TaskDefinitionBase64 = aws.String(base64.StdEncoding.EncodeToString(o))
+}
+
+func TestEnvars_RollOut_NetworkMode(t *testing.T) {
+	// bridge/hostはインスタンスIDとホストポート、EC2上のawsvpcはIPでターゲットに登録される
+	for _, v := range []struct {
+		networkMode string
+		targetType  string
+	}{
+		{"bridge", "instance"},
+		{"host", "instance"},
+		{"awsvpc", "ip"},
+	} {
+		envars := DefaultEnvars()
+		withNetworkMode(envars, v.networkMode)
+		ctrl := gomock.NewController(t)
+		mctx, ctx := envars.Setup(ctrl, 2, "EC2")
+		mctx.TargetType = v.targetType
+		result := envars.RollOut(ctx)
+		if result.Error != nil {
+			t.Fatalf("%s: %s", v.networkMode, result.Error)
+		}
+		assert.False(t, result.ServiceIntact)
+		assert.Equal(t, int64(2), mctx.TaskSize())
+	}
+}
+
+func TestEnvars_ResolveTarget(t *testing.T) {
+	envars := DefaultEnvars()
+	withNetworkMode(envars, "bridge")
+	ctrl := gomock.NewController(t)
+	mctx, ctx := envars.Setup(ctrl, 1, "EC2")
+	mctx.TargetType = "instance"
+	s, _ := mctx.GetService(*envars.Service)
+	var task *ecs.Task
+	for _, v := range mctx.Tasks {
+		task = v
+	}
+	tg, _ := envars.DescribeTargetGroup(ctx, s.LoadBalancers[0].TargetGroupArn)
+	target, err := envars.ResolveTarget(ctx, task, s.LoadBalancers[0], tg)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	// 動的ポートマッピングのホストポートを使う
+	assert.Equal(t, "i-1234567890abcdefg", *target.Id)
+	assert.Equal(t, *task.Containers[0].NetworkBindings[0].HostPort, *target.Port)
+	assert.NotEqual(t, int64(80), *target.Port)
+	// ipターゲットはawsvpcでなければ使えない
+	tg.TargetType = aws.String("ip")
+	_, err = envars.ResolveTarget(ctx, task, s.LoadBalancers[0], tg)
+	assert.NotNil(t, err)
+}
+
+func TestResolveLaunchType(t *testing.T) {
+	assert.Equal(t, "FARGATE", *ResolveLaunchType(&ecs.Task{LaunchType: aws.String("FARGATE")}))
+	assert.Equal(t, "EC2", *ResolveLaunchType(&ecs.Task{LaunchType: aws.String("EC2")}))
+	assert.Equal(t, "FARGATE", *ResolveLaunchType(&ecs.Task{CapacityProviderName: aws.String("FARGATE_SPOT")}))
+	assert.Equal(t, "EC2", *ResolveLaunchType(&ecs.Task{CapacityProviderName: aws.String("asg-provider")}))
+	assert.Equal(t, "EC2", *ResolveLaunchType(&ecs.Task{ContainerInstanceArn: aws.String("arn://instance")}))
+	assert.Nil(t, ResolveLaunchType(&ecs.Task{}))
+}
+
+func setupNlb(t *testing.T, envars *Envars, healthCheckEnabled bool, states ...string) (*test.MockContext, *Context) {
+	ctrl := gomock.NewController(t)
+	mocker, ctx := envars.Setup(ctrl, 2, "FARGATE")
+	albMock := mock_elbv2.NewMockELBV2API(ctrl)
+	albMock.EXPECT().DescribeTargetGroups(gomock.Any()).DoAndReturn(func(input *elbv2.DescribeTargetGroupsInput) (*elbv2.DescribeTargetGroupsOutput, error) {
+		o, _ := mocker.DescribeTargetGroups(input)
+		o.TargetGroups[0].Protocol = aws.String("TCP")
+		o.TargetGroups[0].HealthCheckEnabled = aws.Bool(healthCheckEnabled)
+		return o, nil
+	}).AnyTimes()
+	var calls []*gomock.Call
+	for _, state := range states {
+		state := state
+		calls = append(calls, albMock.EXPECT().DescribeTargetHealth(gomock.Any()).DoAndReturn(func(input *elbv2.DescribeTargetHealthInput) (*elbv2.DescribeTargetHealthOutput, error) {
+			return &elbv2.DescribeTargetHealthOutput{
+				TargetHealthDescriptions: []*elbv2.TargetHealthDescription{{
+					Target:       input.Targets[0],
+					TargetHealth: &elbv2.TargetHealth{State: aws.String(state)},
+				}},
+			}, nil
+		}))
+	}
+	gomock.InOrder(calls...)
+	ctx.Alb = albMock
+	return mocker, ctx
+}
+
+func repeatState(state string, n int) []string {
+	var ret []string
+	for i := 0; i < n; i++ {
+		ret = append(ret, state)
+	}
+	return ret
+}
+
+func TestEnvars_RollOut_Nlb(t *testing.T) {
+	// NLBはターゲットの登録に時間がかかるのでALBより長くunusedを許容する
+	envars := DefaultEnvars()
+	states := append(repeatState("unused", 30), "initial", "initial", "healthy")
+	_, ctx := setupNlb(t, envars, true, states...)
+	result := envars.RollOut(ctx)
+	if result.Error != nil {
+		t.Fatalf("%s", result.Error)
+	}
+	assert.False(t, result.ServiceIntact)
+}
+
+func TestEnvars_RollOut_NlbNeverRegistered(t *testing.T) {
+	envars := DefaultEnvars()
+	_, ctx := setupNlb(t, envars, true, repeatState("unused", 40)...)
+	result := envars.RollOut(ctx)
+	assert.NotNil(t, result.Error)
+	assert.True(t, result.ServiceIntact)
+}
+
+func TestEnvars_RollOut_NlbUnavailable(t *testing.T) {
+	// ヘルスチェックが無効ならunavailableでも進める
+	envars := DefaultEnvars()
+	_, ctx := setupNlb(t, envars, false, "initial", "unavailable")
+	result := envars.RollOut(ctx)
+	assert.Nil(t, result.Error)
+	// ヘルスチェックが有効ならunavailableが続けばエラー
+	envars = DefaultEnvars()
+	_, ctx = setupNlb(t, envars, true, repeatState("unavailable", 8)...)
+	result = envars.RollOut(ctx)
+	assert.NotNil(t, result.Error)
+	assert.True(t, result.ServiceIntact)
+}
+
+func TestIsNetworkTargetGroup(t *testing.T) {
+	for _, v := range []string{"TCP", "UDP", "TLS", "TCP_UDP"} {
+		assert.True(t, IsNetworkTargetGroup(&elbv2.TargetGroup{Protocol: aws.String(v)}))
+	}
+	for _, v := range []string{"HTTP", "HTTPS"} {
+		assert.False(t, IsNetworkTargetGroup(&elbv2.TargetGroup{Protocol: aws.String(v)}))
+	}
+}
@@ -0,0 +1,236 @@
+package cage
+
+import (
+	"fmt"
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"strings"
+	"time"
+)
+
+const kLogPollInterval = time.Duration(5) * time.Second
+const kTaskStoppedPollInterval = time.Duration(6) * time.Second
+
+// 次のタスク定義でコマンドを上書きして1回だけ動かすタスク
+type oneOffTask struct {
+	// ネットワーク設定と起動タイプをサービスから引き継ぐ
+	service        *ecs.Service
+	taskDefinition *ecs.TaskDefinition
+	// 省略すると最初のessentialなコンテナ
+	container   string
+	command     []string
+	environment map[string]string
+	startedBy   string
+	timeout     time.Duration
+}
+
+func (t *oneOffTask) containerDefinition() *ecs.ContainerDefinition {
+	for _, c := range t.taskDefinition.ContainerDefinitions {
+		if t.container == "" && aws.BoolValue(c.Essential) || t.container != "" && aws.StringValue(c.Name) == t.container {
+			return c
+		}
+	}
+	return nil
+}
+
+// EXTERNALとCODE_DEPLOYのサービスはネットワーク設定をサービスではなくプライマリのタスクセットに持つ
+func (t *oneOffTask) networkConfiguration() *ecs.NetworkConfiguration {
+	if t.service.NetworkConfiguration != nil {
+		return t.service.NetworkConfiguration
+	}
+	for _, v := range t.service.TaskSets {
+		if aws.StringValue(v.Status) == "PRIMARY" {
+			return v.NetworkConfiguration
+		}
+	}
+	return nil
+}
+
+// タスクを起動して止まるまで待ち、コンテナの終了コードが0でなければエラーを返す
+// コンテナがawslogsに出力していれば、待っている間にログをloggerに流す
+func (envars *Envars) runOneOffTask(ctx *Context, logger *log.Entry, t *oneOffTask) error {
+	container := t.container
+	def := t.containerDefinition()
+	if def != nil {
+		container = aws.StringValue(def.Name)
+	} else if container == "" {
+		return NewErrorf("no essential container found in task definition '%s'", *t.taskDefinition.TaskDefinitionArn)
+	}
+	override := &ecs.ContainerOverride{Name: aws.String(container)}
+	if len(t.command) > 0 {
+		override.Command = aws.StringSlice(t.command)
+	}
+	for k, v := range t.environment {
+		override.Environment = append(override.Environment, &ecs.KeyValuePair{
+			Name:  aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+	service := t.service
+	input := &ecs.RunTaskInput{
+		Cluster:                  envars.Cluster,
+		TaskDefinition:           t.taskDefinition.TaskDefinitionArn,
+		CapacityProviderStrategy: service.CapacityProviderStrategy,
+		LaunchType:               service.LaunchType,
+		NetworkConfiguration:     t.networkConfiguration(),
+		PlatformVersion:          service.PlatformVersion,
+		StartedBy:                aws.String(t.startedBy),
+		Overrides: &ecs.TaskOverride{
+			ContainerOverrides: []*ecs.ContainerOverride{override},
+		},
+	}
+	if len(service.CapacityProviderStrategy) > 0 {
+		// capacityProviderStrategyとlaunchTypeは同時に指定できない
+		input.LaunchType = nil
+	}
+	o, err := ctx.Ecs.RunTask(input)
+	if err != nil {
+		return err
+	} else if len(o.Failures) > 0 {
+		return NewErrorf("failed to run task: %s", aws.StringValue(o.Failures[0].Reason))
+	} else if len(o.Tasks) == 0 {
+		return NewErrorf("no task started")
+	}
+	taskArn := o.Tasks[0].TaskArn
+	logger = logger.WithField("task", *taskArn)
+	tail := envars.tailContainerLogs(ctx, logger, def, *taskArn)
+	logger.WithField("container", container).Info("waiting for task to stop...")
+	err = envars.waitUntilOneOffTaskStopped(ctx, logger, taskArn, t.timeout)
+	tail.Stop()
+	if err != nil {
+		return err
+	}
+	d, err := ctx.Ecs.DescribeTasks(&ecs.DescribeTasksInput{
+		Cluster: envars.Cluster,
+		Tasks:   []*string{taskArn},
+	})
+	if err != nil {
+		return err
+	} else if len(d.Tasks) == 0 {
+		return NewErrorf("task '%s' not found", *taskArn)
+	}
+	task := d.Tasks[0]
+	for _, c := range task.Containers {
+		if aws.StringValue(c.Name) != container {
+			continue
+		}
+		if c.ExitCode == nil {
+			return NewErrorf("container '%s' exited without exit code: %s", container, aws.StringValue(task.StoppedReason))
+		} else if *c.ExitCode != 0 {
+			return NewErrorf("container '%s' exited with code %d: %s", container, *c.ExitCode, aws.StringValue(task.StoppedReason))
+		}
+		logger.WithFields(log.Fields{"container": container, "exitCode": 0}).Info("container exited")
+		return nil
+	}
+	return NewErrorf("container '%s' not found in task '%s'", container, *taskArn)
+}
+
+// タスクが止まるまでctxの時計でポーリングする。timeoutを過ぎたらタスクを止める
+func (envars *Envars) waitUntilOneOffTaskStopped(ctx *Context, logger *log.Entry, taskArn *string, timeout time.Duration) error {
+	clock := ctx.clock()
+	deadline := clock.Now().Add(timeout)
+	for {
+		o, err := ctx.Ecs.DescribeTasks(&ecs.DescribeTasksInput{
+			Cluster: envars.Cluster,
+			Tasks:   []*string{taskArn},
+		})
+		if err != nil {
+			return err
+		} else if len(o.Failures) > 0 {
+			return NewErrorf("failed to describe task '%s': %s", *taskArn, aws.StringValue(o.Failures[0].Reason))
+		} else if len(o.Tasks) > 0 && aws.StringValue(o.Tasks[0].LastStatus) == "STOPPED" {
+			return nil
+		}
+		if !clock.Now().Before(deadline) {
+			logger.Warn("stopping task timed out...")
+			if _, err := ctx.Ecs.StopTask(&ecs.StopTaskInput{
+				Cluster: envars.Cluster,
+				Task:    taskArn,
+				Reason:  aws.String(fmt.Sprintf("cage: timed out after %s", timeout)),
+			}); err != nil {
+				logger.WithError(err).Error("failed to stop task")
+			}
+			return NewErrorf("task '%s' hasn't stopped within %s", *taskArn, timeout)
+		}
+		<-clock.NewTimer(kTaskStoppedPollInterval).C
+	}
+}
+
+// awslogsのログストリームを追いかけてloggerに出す
+type logTail struct {
+	stop chan struct{}
+	done chan struct{}
+}
+
+// 止めると残りのログを読み切ってから返る
+func (t *logTail) Stop() {
+	if t == nil {
+		return
+	}
+	close(t.stop)
+	<-t.done
+}
+
+func (envars *Envars) tailContainerLogs(ctx *Context, logger *log.Entry, def *ecs.ContainerDefinition, taskArn string) *logTail {
+	if ctx.Logs == nil || def == nil || def.LogConfiguration == nil ||
+		aws.StringValue(def.LogConfiguration.LogDriver) != ecs.LogDriverAwslogs {
+		return nil
+	}
+	options := def.LogConfiguration.Options
+	group := aws.StringValue(options["awslogs-group"])
+	prefix := aws.StringValue(options["awslogs-stream-prefix"])
+	if group == "" || prefix == "" {
+		logger.Warn("logs of container can't be followed without awslogs-group and awslogs-stream-prefix")
+		return nil
+	}
+	// ログストリーム名は prefix/コンテナ名/タスクID
+	taskId := taskArn[strings.LastIndex(taskArn, "/")+1:]
+	stream := fmt.Sprintf("%s/%s/%s", prefix, *def.Name, taskId)
+	logger = logger.WithFields(log.Fields{
+		"container": *def.Name,
+		"logStream": stream,
+	})
+	t := &logTail{stop: make(chan struct{}), done: make(chan struct{})}
+	var token *string
+	read := func() {
+		for {
+			o, err := ctx.Logs.GetLogEvents(&cloudwatchlogs.GetLogEventsInput{
+				LogGroupName:  aws.String(group),
+				LogStreamName: aws.String(stream),
+				StartFromHead: aws.Bool(true),
+				NextToken:     token,
+			})
+			if err != nil {
+				// タスクが起動するまではログストリームがない
+				if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != cloudwatchlogs.ErrCodeResourceNotFoundException {
+					logger.WithError(err).Warn("failed to get log events")
+				}
+				return
+			}
+			for _, e := range o.Events {
+				logger.Info(aws.StringValue(e.Message))
+			}
+			if aws.StringValue(o.NextForwardToken) == aws.StringValue(token) {
+				return
+			}
+			token = o.NextForwardToken
+		}
+	}
+	clock := ctx.clock()
+	go func() {
+		defer close(t.done)
+		for {
+			select {
+			case <-t.stop:
+				read()
+				return
+			case <-clock.NewTimer(kLogPollInterval).C:
+				read()
+			}
+		}
+	}()
+	return t
+}
@@ -0,0 +1,139 @@
+package cage
+
+import (
+	"fmt"
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"strings"
+)
+
+type SecretReference struct {
+	ContainerName string
+	Name          string
+	ValueFrom     string
+}
+
+func (r *SecretReference) String() string {
+	return fmt.Sprintf("container '%s' secret '%s' (%s)", r.ContainerName, r.Name, r.ValueFrom)
+}
+
+// containerDefinitions[].secrets に書かれた SSM パラメータと Secrets Manager のシークレットが存在するかを確認する
+// 値は読まずに DescribeParameters / DescribeSecret で存在だけを確かめる
+func (envars *Envars) EnsureSecretsExist(ctx *Context, containers []*ecs.ContainerDefinition) error {
+	logger := envars.logger(ctx)
+	refs := CollectSecretReferences(containers)
+	if len(refs) == 0 {
+		return nil
+	}
+	var missing []*SecretReference
+	checked := make(map[string]bool)
+	for _, ref := range refs {
+		exists, ok := checked[ref.ValueFrom]
+		if !ok {
+			var err error
+			if exists, err = SecretExists(ctx, ref.ValueFrom); err != nil {
+				return NewErrorf("failed to check %s due to: %s", ref, err)
+			}
+			checked[ref.ValueFrom] = exists
+		}
+		if !exists {
+			missing = append(missing, ref)
+		}
+	}
+	if len(missing) > 0 {
+		var lines []string
+		for _, ref := range missing {
+			logger.WithFields(log.Fields{
+				"container": ref.ContainerName,
+				"secret":    ref.Name,
+				"valueFrom": ref.ValueFrom,
+			}).Error("secret reference is not found")
+			lines = append(lines, ref.String())
+		}
+		return NewErrorf("%d secret reference(s) in next task definition not found: %s", len(missing), strings.Join(lines, ", "))
+	}
+	logger.WithField("count", len(refs)).Info("all secret references in next task definition exist")
+	return nil
+}
+
+func CollectSecretReferences(containers []*ecs.ContainerDefinition) []*SecretReference {
+	var ret []*SecretReference
+	for _, c := range containers {
+		for _, s := range c.Secrets {
+			if isEmpty(s.ValueFrom) {
+				continue
+			}
+			ret = append(ret, &SecretReference{
+				ContainerName: aws.StringValue(c.Name),
+				Name:          aws.StringValue(s.Name),
+				ValueFrom:     *s.ValueFrom,
+			})
+		}
+	}
+	return ret
+}
+
+func SecretExists(ctx *Context, valueFrom string) (bool, error) {
+	// arn:partition:service:region:account:resource
+	if strings.HasPrefix(valueFrom, "arn:") && len(strings.Split(valueFrom, ":")) < 6 {
+		return false, NewErrorf("valueFrom '%s' is not a valid arn", valueFrom)
+	}
+	if strings.HasPrefix(valueFrom, "arn:") && strings.Split(valueFrom, ":")[2] == "secretsmanager" {
+		if ctx.Secrets == nil {
+			return false, NewErrorf("secrets manager client is not configured")
+		}
+		_, err := ctx.Secrets.DescribeSecret(&secretsmanager.DescribeSecretInput{
+			SecretId: aws.String(secretArn(valueFrom)),
+		})
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == secretsmanager.ErrCodeResourceNotFoundException {
+			return false, nil
+		} else if err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+	if ctx.Ssm == nil {
+		return false, NewErrorf("ssm client is not configured")
+	}
+	o, err := ctx.Ssm.DescribeParameters(&ssm.DescribeParametersInput{
+		ParameterFilters: []*ssm.ParameterStringFilter{{
+			Key:    aws.String("Name"),
+			Option: aws.String("Equals"),
+			Values: []*string{aws.String(parameterName(valueFrom))},
+		}},
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(o.Parameters) > 0, nil
+}
+
+// arn:aws:secretsmanager:region:account:secret:name[:json-key:version-stage:version-id]
+func secretArn(valueFrom string) string {
+	parts := strings.Split(valueFrom, ":")
+	if len(parts) > 7 {
+		parts = parts[:7]
+	}
+	return strings.Join(parts, ":")
+}
+
+// arn:aws:ssm:region:account:parameter/name または名前そのもの
+// 階層のない名前 (parameter/name) は先頭の / を付けない
+func parameterName(valueFrom string) string {
+	if !strings.HasPrefix(valueFrom, "arn:") {
+		return valueFrom
+	}
+	idx := strings.Index(valueFrom, ":parameter/")
+	if idx < 0 {
+		return valueFrom
+	}
+	name := valueFrom[idx+len(":parameter"):]
+	if strings.Count(name, "/") == 1 {
+		name = strings.TrimPrefix(name, "/")
+	}
+	return name
+}
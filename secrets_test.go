@@ -0,0 +1,129 @@
+package cage
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/golang/mock/gomock"
+	"github.com/loilo-inc/canarycage/mock/mock_secretsmanager"
+	"github.com/loilo-inc/canarycage/mock/mock_ssm"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const kSecretArn = "arn:aws:secretsmanager:us-west-2:1234567890:secret:db-password-AbCdEf"
+const kParameterArn = "arn:aws:ssm:us-west-2:1234567890:parameter/app/api-key"
+
+func envarsWithSecrets(secrets ...*ecs.Secret) *Envars {
+	td := &ecs.RegisterTaskDefinitionInput{
+		Family: aws.String("family"),
+		ContainerDefinitions: []*ecs.ContainerDefinition{{
+			Name:    aws.String("container"),
+			Secrets: secrets,
+		}},
+	}
+	d, _ := json.Marshal(td)
+	envars := DefaultEnvars()
+	envars.TaskDefinitionBase64 = aws.String(base64.StdEncoding.EncodeToString(d))
+	return envars
+}
+
+func setupSecrets(ctrl *gomock.Controller, ctx *Context, existing ...string) {
+	exists := make(map[string]bool)
+	for _, v := range existing {
+		exists[v] = true
+	}
+	ssmMock := mock_ssm.NewMockSSMAPI(ctrl)
+	smMock := mock_secretsmanager.NewMockSecretsManagerAPI(ctrl)
+	ssmMock.EXPECT().DescribeParameters(gomock.Any()).DoAndReturn(func(input *ssm.DescribeParametersInput) (*ssm.DescribeParametersOutput, error) {
+		name := *input.ParameterFilters[0].Values[0]
+		if !exists[name] {
+			return &ssm.DescribeParametersOutput{}, nil
+		}
+		return &ssm.DescribeParametersOutput{
+			Parameters: []*ssm.ParameterMetadata{{Name: aws.String(name)}},
+		}, nil
+	}).AnyTimes()
+	smMock.EXPECT().DescribeSecret(gomock.Any()).DoAndReturn(func(input *secretsmanager.DescribeSecretInput) (*secretsmanager.DescribeSecretOutput, error) {
+		if !exists[*input.SecretId] {
+			return nil, awserr.New(secretsmanager.ErrCodeResourceNotFoundException, "not found", nil)
+		}
+		return &secretsmanager.DescribeSecretOutput{ARN: input.SecretId}, nil
+	}).AnyTimes()
+	ctx.Ssm = ssmMock
+	ctx.Secrets = smMock
+}
+
+func TestEnvars_EnsureSecretsExist(t *testing.T) {
+	envars := envarsWithSecrets(
+		&ecs.Secret{Name: aws.String("DB_PASSWORD"), ValueFrom: aws.String(kSecretArn + ":password::")},
+		&ecs.Secret{Name: aws.String("API_KEY"), ValueFrom: aws.String(kParameterArn)},
+		&ecs.Secret{Name: aws.String("TOKEN"), ValueFrom: aws.String("token")},
+	)
+	ctrl := gomock.NewController(t)
+	_, ctx := envars.Setup(ctrl, 1, "FARGATE")
+	setupSecrets(ctrl, ctx, kSecretArn, "/app/api-key", "token")
+	_, td, _ := envars.loadNextTaskDefinition(ctx.Ecs)
+	assert.Nil(t, envars.EnsureSecretsExist(ctx, td.ContainerDefinitions))
+}
+
+func TestEnvars_EnsureSecretsExist2(t *testing.T) {
+	// 存在しない参照があればまとめてエラーにする
+	envars := envarsWithSecrets(
+		&ecs.Secret{Name: aws.String("DB_PASSWORD"), ValueFrom: aws.String(kSecretArn)},
+		&ecs.Secret{Name: aws.String("API_KEY"), ValueFrom: aws.String(kParameterArn)},
+		&ecs.Secret{Name: aws.String("TOKEN"), ValueFrom: aws.String("token")},
+	)
+	ctrl := gomock.NewController(t)
+	_, ctx := envars.Setup(ctrl, 1, "FARGATE")
+	setupSecrets(ctrl, ctx, "token")
+	_, td, _ := envars.loadNextTaskDefinition(ctx.Ecs)
+	err := envars.EnsureSecretsExist(ctx, td.ContainerDefinitions)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "DB_PASSWORD")
+		assert.Contains(t, err.Error(), "API_KEY")
+		assert.NotContains(t, err.Error(), "TOKEN")
+	}
+}
+
+func TestEnvars_RollOut_MissingSecrets(t *testing.T) {
+	// シークレットが見つからなければカナリアを作らずに終了する
+	envars := envarsWithSecrets(
+		&ecs.Secret{Name: aws.String("API_KEY"), ValueFrom: aws.String(kParameterArn)},
+	)
+	ctrl := gomock.NewController(t)
+	mocker, ctx := envars.Setup(ctrl, 2, "FARGATE")
+	setupSecrets(ctrl, ctx)
+	result := envars.RollOut(ctx)
+	assert.NotNil(t, result.Error)
+	assert.True(t, result.ServiceIntact)
+	assert.Equal(t, int64(1), mocker.ServiceSize())
+}
+
+func TestSecretExists_MalformedArn(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := &Context{}
+	setupSecrets(ctrl, ctx)
+	for _, v := range []string{"arn:aws", "arn:aws:secretsmanager:us-west-2"} {
+		_, err := SecretExists(ctx, v)
+		if assert.NotNil(t, err) {
+			assert.Equal(t, "valueFrom '"+v+"' is not a valid arn", err.Error())
+		}
+	}
+}
+
+func TestParameterName(t *testing.T) {
+	assert.Equal(t, "/app/api-key", parameterName(kParameterArn))
+	assert.Equal(t, "api-key", parameterName("arn:aws:ssm:us-west-2:1234567890:parameter/api-key"))
+	assert.Equal(t, "api-key", parameterName("api-key"))
+	assert.Equal(t, "/app/api-key", parameterName("/app/api-key"))
+}
+
+func TestSecretArn(t *testing.T) {
+	assert.Equal(t, kSecretArn, secretArn(kSecretArn))
+	assert.Equal(t, kSecretArn, secretArn(kSecretArn+":password:AWSCURRENT:"))
+}
@@ -0,0 +1,151 @@
+package cage
+
+import (
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"strings"
+	"time"
+)
+
+// ServiceRegistriesを持つサービスはカナリアのタスクがCloud Mapに登録されて
+// HEALTHYになるまで待つ
+const kServiceDiscoveryPollInterval = time.Duration(15) * time.Second
+const kServiceDiscoveryMaxAttempts = 40
+
+// arn:aws:servicediscovery:region:account:service/srv-xxxx -> srv-xxxx
+func lastArnSegment(arn string) string {
+	return arn[strings.LastIndex(arn, "/")+1:]
+}
+
+// --canaryServiceRegistryArn が指定された場合は本番のサービスディスカバリのトラフィックを受けないように
+// カナリアを別のCloud Mapサービスに登録する。ポートなどの設定は元のものを引き継ぐ
+func (envars *Envars) canaryServiceRegistries(registries []*ecs.ServiceRegistry) []*ecs.ServiceRegistry {
+	if isEmpty(envars.CanaryServiceRegistryArn) {
+		return registries
+	}
+	ret := &ecs.ServiceRegistry{}
+	if len(registries) > 0 {
+		ret.ContainerName = registries[0].ContainerName
+		ret.ContainerPort = registries[0].ContainerPort
+		ret.Port = registries[0].Port
+	}
+	ret.RegistryArn = envars.CanaryServiceRegistryArn
+	return []*ecs.ServiceRegistry{ret}
+}
+
+func (envars *Envars) EnsureServiceDiscoveryHealthy(ctx *Context) error {
+	logger := envars.logger(ctx)
+	o, err := ctx.Ecs.DescribeServices(&ecs.DescribeServicesInput{
+		Cluster:  envars.Cluster,
+		Services: []*string{envars.CanaryService},
+	})
+	if err != nil {
+		return err
+	} else if len(o.Services) == 0 {
+		return NewErrorf("canary service '%s' not found", *envars.CanaryService)
+	}
+	var registries []*ecs.ServiceRegistry
+	for _, v := range o.Services[0].ServiceRegistries {
+		if !isEmpty(v.RegistryArn) {
+			registries = append(registries, v)
+		}
+	}
+	if len(registries) == 0 {
+		return nil
+	}
+	if ctx.ServiceDiscovery == nil {
+		return NewErrorf("servicediscovery client is not configured")
+	}
+	tasks, err := ctx.Ecs.ListTasks(&ecs.ListTasksInput{
+		Cluster:     envars.Cluster,
+		ServiceName: envars.CanaryService,
+	})
+	if err != nil {
+		return err
+	} else if len(tasks.TaskArns) == 0 {
+		return NewErrorf("no canary task found")
+	}
+	// ECSはタスクIDをインスタンスIDとして登録する
+	instanceId := lastArnSegment(*tasks.TaskArns[0])
+	for _, v := range registries {
+		serviceId := lastArnSegment(*v.RegistryArn)
+		logger.WithFields(log.Fields{
+			"instance":                instanceId,
+			"serviceDiscoveryService": serviceId,
+		}).Info("ensuring canary task to become healthy in service discovery...")
+		if err := envars.WaitUntilInstanceHealthy(ctx, serviceId, instanceId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (envars *Envars) WaitUntilInstanceHealthy(ctx *Context, serviceId string, instanceId string) error {
+	logger := envars.logger(ctx).WithFields(log.Fields{
+		"instance":                instanceId,
+		"serviceDiscoveryService": serviceId,
+	})
+	svc, err := ctx.ServiceDiscovery.GetService(&servicediscovery.GetServiceInput{
+		Id: aws.String(serviceId),
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to get service discovery service")
+		return err
+	}
+	// ヘルスチェックがないサービスは登録されるだけでよい
+	healthChecked := svc.Service.HealthCheckConfig != nil || svc.Service.HealthCheckCustomConfig != nil
+	recentState := "NOT_REGISTERED"
+	for i := 0; i < kServiceDiscoveryMaxAttempts; i++ {
+		<-ctx.clock().NewTimer(kServiceDiscoveryPollInterval).C
+		registered, err := envars.isInstanceRegistered(ctx, serviceId, instanceId)
+		if err != nil {
+			return err
+		} else if !registered {
+			logger.Info("instance is not registered yet")
+			continue
+		}
+		if !healthChecked {
+			logger.Info("instance is registered")
+			return nil
+		}
+		o, err := ctx.ServiceDiscovery.GetInstancesHealthStatus(&servicediscovery.GetInstancesHealthStatusInput{
+			ServiceId: aws.String(serviceId),
+			Instances: []*string{aws.String(instanceId)},
+		})
+		if err != nil {
+			return err
+		}
+		recentState = aws.StringValue(o.Status[instanceId])
+		logger.WithField("state", recentState).Info("instance health status")
+		if recentState == servicediscovery.HealthStatusHealthy {
+			return nil
+		}
+	}
+	return NewErrorf(
+		"instance '%s' hasn't become to healthy in service discovery '%s' within maximum attempt windows. Recent state: %s",
+		instanceId, serviceId, recentState,
+	)
+}
+
+func (envars *Envars) isInstanceRegistered(ctx *Context, serviceId string, instanceId string) (bool, error) {
+	input := &servicediscovery.ListInstancesInput{
+		ServiceId: aws.String(serviceId),
+	}
+	for {
+		o, err := ctx.ServiceDiscovery.ListInstances(input)
+		if err != nil {
+			return false, err
+		}
+		for _, v := range o.Instances {
+			if aws.StringValue(v.Id) == instanceId {
+				return true, nil
+			}
+		}
+		if o.NextToken == nil {
+			return false, nil
+		}
+		input.NextToken = o.NextToken
+	}
+}
@@ -0,0 +1,77 @@
+package cage
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/golang/mock/gomock"
+	"github.com/loilo-inc/canarycage/mock/mock_servicediscovery"
+	"github.com/loilo-inc/canarycage/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const kTestRegistryArn = "arn:aws:servicediscovery:us-west-2:1234567890:service/srv-current"
+
+func setupServiceDiscovery(t *testing.T, envars *Envars) (*test.MockContext, *test.MockServiceDiscovery, *Context) {
+	ctrl := gomock.NewController(t)
+	mocker, ctx := envars.Setup(ctrl, 2, "FARGATE")
+	s, _ := mocker.GetService(*envars.Service)
+	s.ServiceRegistries = []*ecs.ServiceRegistry{{
+		RegistryArn: aws.String(kTestRegistryArn),
+		Port:        aws.Int64(80),
+	}}
+	sd := test.NewMockServiceDiscovery(mocker)
+	sdMock := mock_servicediscovery.NewMockServiceDiscoveryAPI(ctrl)
+	sdMock.EXPECT().GetService(gomock.Any()).DoAndReturn(sd.GetService).AnyTimes()
+	sdMock.EXPECT().ListInstances(gomock.Any()).DoAndReturn(sd.ListInstances).AnyTimes()
+	sdMock.EXPECT().GetInstancesHealthStatus(gomock.Any()).DoAndReturn(sd.GetInstancesHealthStatus).AnyTimes()
+	ctx.ServiceDiscovery = sdMock
+	return mocker, sd, ctx
+}
+
+func TestEnvars_RollOut_ServiceDiscovery(t *testing.T) {
+	for _, healthCheck := range []bool{true, false} {
+		envars := DefaultEnvars()
+		mocker, sd, ctx := setupServiceDiscovery(t, envars)
+		sd.HealthCheck = healthCheck
+		result := envars.RollOut(ctx)
+		if result.Error != nil {
+			t.Fatalf("%s", result.Error)
+		}
+		assert.False(t, result.ServiceIntact)
+		assert.Equal(t, int64(1), mocker.ServiceSize())
+	}
+}
+
+func TestEnvars_RollOut_ServiceDiscoveryUnhealthy(t *testing.T) {
+	// Cloud MapでHEALTHYにならなければ本番のサービスは更新しない
+	envars := DefaultEnvars()
+	_, sd, ctx := setupServiceDiscovery(t, envars)
+	sd.HealthStatus = "UNHEALTHY"
+	result := envars.RollOut(ctx)
+	assert.NotNil(t, result.Error)
+	assert.True(t, result.ServiceIntact)
+}
+
+func TestEnvars_CreateCanaryService_CanaryServiceRegistryArn(t *testing.T) {
+	// カナリアを別のCloud Mapサービスに登録する
+	envars := DefaultEnvars()
+	envars.CanaryServiceRegistryArn = aws.String("arn:aws:servicediscovery:us-west-2:1234567890:service/srv-canary")
+	mocker, sd, ctx := setupServiceDiscovery(t, envars)
+	s, _ := mocker.GetService(*envars.Service)
+	if err := envars.CreateCanaryService(ctx, s.TaskDefinition); err != nil {
+		t.Fatalf("%s", err)
+	}
+	canary, _ := mocker.GetService(*envars.CanaryService)
+	assert.Equal(t, 1, len(canary.ServiceRegistries))
+	assert.Equal(t, *envars.CanaryServiceRegistryArn, *canary.ServiceRegistries[0].RegistryArn)
+	assert.Equal(t, int64(80), *canary.ServiceRegistries[0].Port)
+	if o, _ := sd.ListInstances(&servicediscovery.ListInstancesInput{
+		ServiceId: aws.String("srv-current"),
+	}); assert.NotNil(t, o) {
+		// 本番のサービスには現在のタスクだけ
+		assert.Equal(t, 2, len(o.Instances))
+	}
+	assert.Nil(t, envars.EnsureServiceDiscoveryHealthy(ctx))
+}
@@ -0,0 +1,220 @@
+package cage
+
+import (
+	"fmt"
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const SmokeTestViaDirect = "direct"
+const SmokeTestViaAlb = "alb"
+
+const kSmokeTestHttpTimeout = time.Duration(30) * time.Second
+
+// レスポンスの本文はこれ以上読まない
+const kSmokeTestMaxBodySize = 1 << 20
+
+// deployコンテクストの cage.json の "smokeTests" に書く
+// カナリアタスクが健康になった後、プライマリを更新する前にHTTPリクエストを送って確かめる
+type SmokeTestConfig struct {
+	// direct: カナリアタスクのIPとポートに直接送る (ターゲットタイプがipのときだけ)
+	// alb: canaryRouteのルールでカナリアに振り分けられるヘッダーを付けてbaseUrlに送る
+	Via string `json:"via,omitempty"`
+	// direct のスキーム。デフォルトは http
+	Scheme string `json:"scheme,omitempty"`
+	// alb のときのリクエスト先。例: https://app.example.com
+	BaseUrl string        `json:"baseUrl,omitempty"`
+	Checks  []*SmokeCheck `json:"checks"`
+}
+
+type SmokeCheck struct {
+	Name string `json:"name"`
+	// デフォルトは GET
+	Method  string            `json:"method,omitempty"`
+	Path    string            `json:"path"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    string            `json:"body,omitempty"`
+	// デフォルトは 200
+	ExpectedStatus int `json:"expectedStatus,omitempty"`
+	// レスポンスの本文がマッチしなければ失敗
+	BodyRegex string `json:"bodyRegex,omitempty"`
+	// 例: "500ms"。超えたら失敗
+	MaxLatency string `json:"maxLatency,omitempty"`
+}
+
+type SmokeCheckResult struct {
+	Name       string
+	Url        string
+	StatusCode int
+	Latency    time.Duration
+	// 成功したら空
+	Error string
+}
+
+func validateSmokeTests(s *SmokeTestConfig, route *CanaryRouteConfig) error {
+	if s == nil {
+		return nil
+	}
+	switch s.Via {
+	case "", SmokeTestViaDirect:
+	case SmokeTestViaAlb:
+		if route == nil {
+			return NewErrorf("smoke tests via alb require canaryRoute")
+		} else if s.BaseUrl == "" {
+			return NewErrorf("baseUrl of smokeTests is required to send requests via alb")
+		}
+	default:
+		return NewErrorf("via of smokeTests must be '%s' or '%s' but got '%s'", SmokeTestViaDirect, SmokeTestViaAlb, s.Via)
+	}
+	if len(s.Checks) == 0 {
+		return NewErrorf("checks of smokeTests are required")
+	}
+	for i, c := range s.Checks {
+		if c.Name == "" {
+			return NewErrorf("name of smokeTests.checks[%d] is required", i)
+		}
+		if !strings.HasPrefix(c.Path, "/") {
+			return NewErrorf("path of smoke check '%s' must start with '/': '%s'", c.Name, c.Path)
+		}
+		if c.BodyRegex != "" {
+			if _, err := regexp.Compile(c.BodyRegex); err != nil {
+				return NewErrorf("bodyRegex of smoke check '%s' is invalid: %s", c.Name, err)
+			}
+		}
+		if c.MaxLatency != "" {
+			if d, err := time.ParseDuration(c.MaxLatency); err != nil || d <= 0 {
+				return NewErrorf("maxLatency of smoke check '%s' must be a positive duration: '%s'", c.Name, c.MaxLatency)
+			}
+		}
+	}
+	return nil
+}
+
+var smokeTestClient = &http.Client{
+	Timeout: kSmokeTestHttpTimeout,
+	// リダイレクトはexpectedStatusで確かめる
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+// カナリアタスクにスモークテストを送り、1つでも失敗すればエラーを返す
+// targetはEnsureTaskHealthyで解決したカナリアタスクのターゲット。routeはalbのときにOpenCanaryRouteで作ったもの
+func (envars *Envars) RunSmokeTests(ctx *Context, target *elbv2.TargetDescription, route *CanaryRoute, ret *RollOutResult) error {
+	s := envars.SmokeTests
+	if target == nil {
+		return NewErrorf("smoke tests require the canary task to be registered to a load balancer")
+	} else if s.Via == SmokeTestViaAlb && route == nil {
+		return NewErrorf("smoke tests via alb require canary route to be opened")
+	}
+	span := ctx.Tracer.Start("smoke_tests")
+	err := envars.runSmokeTests(ctx, target, route, ret)
+	span.SetError(err)
+	span.End()
+	if err == nil {
+		envars.logger(ctx).WithField("count", len(s.Checks)).Info("all smoke checks passed")
+	}
+	return err
+}
+
+func (envars *Envars) runSmokeTests(ctx *Context, target *elbv2.TargetDescription, route *CanaryRoute, ret *RollOutResult) error {
+	s := envars.SmokeTests
+	var baseUrl string
+	headers := make(map[string]string)
+	if s.Via == SmokeTestViaAlb {
+		baseUrl = strings.TrimSuffix(s.BaseUrl, "/")
+		headers[route.HeaderName] = route.HeaderValue
+	} else {
+		// instanceターゲットのIDはEC2インスタンスIDでリクエストを送れない
+		if net.ParseIP(aws.StringValue(target.Id)) == nil {
+			return NewErrorf("smoke tests via direct require target type 'ip'. use canaryRoute and via 'alb' instead")
+		}
+		scheme := s.Scheme
+		if scheme == "" {
+			scheme = "http"
+		}
+		baseUrl = fmt.Sprintf("%s://%s:%d", scheme, *target.Id, *target.Port)
+	}
+	var failed []string
+	for _, c := range s.Checks {
+		result := runSmokeCheck(baseUrl, headers, c)
+		if ret != nil {
+			ret.SmokeChecks = append(ret.SmokeChecks, result)
+		}
+		logger := envars.logger(ctx).WithFields(log.Fields{
+			"check":   c.Name,
+			"url":     result.Url,
+			"status":  result.StatusCode,
+			"latency": result.Latency.String(),
+		})
+		if result.Error != "" {
+			logger.WithField("error", result.Error).Error("smoke check failed")
+			failed = append(failed, fmt.Sprintf("%s (%s)", c.Name, result.Error))
+		} else {
+			logger.Info("smoke check passed")
+		}
+	}
+	if len(failed) > 0 {
+		return NewErrorf("%d smoke check(s) failed: %s", len(failed), strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+func runSmokeCheck(baseUrl string, headers map[string]string, c *SmokeCheck) *SmokeCheckResult {
+	method := c.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	ret := &SmokeCheckResult{Name: c.Name, Url: baseUrl + c.Path}
+	req, err := http.NewRequest(method, ret.Url, strings.NewReader(c.Body))
+	if err != nil {
+		ret.Error = err.Error()
+		return ret
+	}
+	for k, v := range c.Headers {
+		req.Header.Set(k, v)
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	if host, ok := c.Headers["Host"]; ok {
+		req.Host = host
+	}
+	// レイテンシは実時間で測る
+	start := time.Now()
+	resp, err := smokeTestClient.Do(req)
+	if err != nil {
+		ret.Error = err.Error()
+		return ret
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, kSmokeTestMaxBodySize))
+	ret.Latency = time.Since(start)
+	ret.StatusCode = resp.StatusCode
+	if err != nil {
+		ret.Error = fmt.Sprintf("failed to read body: %s", err)
+		return ret
+	}
+	expected := c.ExpectedStatus
+	if expected == 0 {
+		expected = http.StatusOK
+	}
+	if resp.StatusCode != expected {
+		ret.Error = fmt.Sprintf("expected status %d but got %d", expected, resp.StatusCode)
+	} else if c.BodyRegex != "" && !regexp.MustCompile(c.BodyRegex).Match(body) {
+		ret.Error = fmt.Sprintf("body doesn't match /%s/", c.BodyRegex)
+	} else if c.MaxLatency != "" {
+		if max, _ := time.ParseDuration(c.MaxLatency); ret.Latency > max {
+			ret.Error = fmt.Sprintf("latency %s exceeded %s", ret.Latency, max)
+		}
+	}
+	return ret
+}
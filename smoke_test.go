@@ -0,0 +1,187 @@
+package cage
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidateSmokeTests(t *testing.T) {
+	checks := []*SmokeCheck{{Name: "health", Path: "/health"}}
+	route := &CanaryRouteConfig{ListenerArn: "listener", TargetGroupArn: "tg"}
+	assert.Nil(t, validateSmokeTests(nil, nil))
+	assert.Nil(t, validateSmokeTests(&SmokeTestConfig{Checks: checks}, nil))
+	assert.Nil(t, validateSmokeTests(&SmokeTestConfig{Via: "alb", BaseUrl: "https://example.com", Checks: checks}, route))
+	for _, v := range []*SmokeTestConfig{
+		{Via: "nlb", Checks: checks},
+		{Via: "alb", Checks: checks},
+		{},
+		{Checks: []*SmokeCheck{{Path: "/"}}},
+		{Checks: []*SmokeCheck{{Name: "a", Path: "health"}}},
+		{Checks: []*SmokeCheck{{Name: "a", Path: "/", BodyRegex: "("}}},
+		{Checks: []*SmokeCheck{{Name: "a", Path: "/", MaxLatency: "fast"}}},
+	} {
+		assert.NotNil(t, validateSmokeTests(v, nil), "%+v", v)
+	}
+	assert.NotNil(t, validateSmokeTests(&SmokeTestConfig{Via: "alb", Checks: checks}, route))
+	assert.NotNil(t, validateCanaryRoute(&CanaryRouteConfig{TargetGroupArn: "tg"}))
+}
+
+func smokeTestServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/health":
+			w.Write([]byte(`{"status":"ok"}`))
+		case "/echo":
+			body, _ := ioutil.ReadAll(r.Body)
+			w.Header().Set("Content-Type", "text/plain")
+			w.Write([]byte(r.Method + " " + r.Header.Get("X-Test") + " " + string(body)))
+		case "/slow":
+			time.Sleep(50 * time.Millisecond)
+		case "/canary":
+			if r.Header.Get(kDefaultCanaryHeaderName) != "service-canary" {
+				w.WriteHeader(http.StatusNotFound)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestRunSmokeCheck(t *testing.T) {
+	server := smokeTestServer()
+	defer server.Close()
+	result := runSmokeCheck(server.URL, nil, &SmokeCheck{Name: "health", Path: "/health", BodyRegex: `"status":"ok"`})
+	assert.Equal(t, "", result.Error)
+	assert.Equal(t, 200, result.StatusCode)
+	assert.Equal(t, server.URL+"/health", result.Url)
+	result = runSmokeCheck(server.URL, nil, &SmokeCheck{
+		Name:      "echo",
+		Method:    "POST",
+		Path:      "/echo",
+		Headers:   map[string]string{"X-Test": "header"},
+		Body:      "body",
+		BodyRegex: "^POST header body$",
+	})
+	assert.Equal(t, "", result.Error)
+	result = runSmokeCheck(server.URL, nil, &SmokeCheck{Name: "echo", Path: "/echo", BodyRegex: "^POST"})
+	assert.Equal(t, "body doesn't match /^POST/", result.Error)
+	result = runSmokeCheck(server.URL, nil, &SmokeCheck{Name: "missing", Path: "/missing"})
+	assert.Equal(t, "expected status 200 but got 404", result.Error)
+	assert.Equal(t, "", runSmokeCheck(server.URL, nil, &SmokeCheck{Name: "missing", Path: "/missing", ExpectedStatus: 404}).Error)
+	result = runSmokeCheck(server.URL, nil, &SmokeCheck{Name: "slow", Path: "/slow", MaxLatency: "10ms"})
+	assert.True(t, strings.HasPrefix(result.Error, "latency"), result.Error)
+	result = runSmokeCheck("http://127.0.0.1:1", nil, &SmokeCheck{Name: "refused", Path: "/"})
+	assert.NotEqual(t, "", result.Error)
+}
+
+// カナリアタスクのIPは127.0.0.1なので、コンテナポートをテストサーバーのポートにする
+func setupSmokeTest(t *testing.T, envars *Envars, server *httptest.Server) *Context {
+	u, _ := url.Parse(server.URL)
+	port, _ := strconv.ParseInt(u.Port(), 10, 64)
+	ctrl := gomock.NewController(t)
+	mocker, ctx := envars.Setup(ctrl, 2, "FARGATE")
+	service, _ := mocker.GetService(*envars.Service)
+	service.LoadBalancers[0].ContainerPort = aws.Int64(port)
+	return ctx
+}
+
+func TestEnvars_RollOut_SmokeTests(t *testing.T) {
+	server := smokeTestServer()
+	defer server.Close()
+	envars := DefaultEnvars()
+	envars.SmokeTests = &SmokeTestConfig{Checks: []*SmokeCheck{
+		{Name: "health", Path: "/health"},
+		{Name: "echo", Method: "PUT", Path: "/echo", Body: "x", BodyRegex: "PUT"},
+	}}
+	result := envars.RollOut(setupSmokeTest(t, envars, server))
+	if result.Error != nil {
+		t.Fatalf(result.Error.Error())
+	}
+	if assert.Equal(t, 2, len(result.SmokeChecks)) {
+		assert.Equal(t, "health", result.SmokeChecks[0].Name)
+		assert.Equal(t, 200, result.SmokeChecks[0].StatusCode)
+	}
+	assert.True(t, strings.Contains(RollOutSummary(envars, result), "| Smoke check |"))
+}
+
+func TestEnvars_RollOut_SmokeTestsFailed(t *testing.T) {
+	server := smokeTestServer()
+	defer server.Close()
+	envars := DefaultEnvars()
+	envars.SmokeTests = &SmokeTestConfig{Checks: []*SmokeCheck{
+		{Name: "health", Path: "/health"},
+		{Name: "missing", Path: "/missing"},
+	}}
+	result := envars.RollOut(setupSmokeTest(t, envars, server))
+	if assert.NotNil(t, result.Error) {
+		assert.True(t, strings.Contains(result.Error.Error(), "missing (expected status 200 but got 404)"), result.Error.Error())
+	}
+	assert.True(t, result.ServiceIntact)
+	assert.Equal(t, 2, len(result.SmokeChecks))
+}
+
+func TestEnvars_RollOut_SmokeTestsViaAlb(t *testing.T) {
+	server := smokeTestServer()
+	defer server.Close()
+	envars := DefaultEnvars()
+	envars.CanaryRoute = &CanaryRouteConfig{
+		ListenerArn:    "arn://listener",
+		TargetGroupArn: "arn://aaa/hoge/targetgroup/canary/ccc",
+	}
+	envars.SmokeTests = &SmokeTestConfig{
+		Via:     SmokeTestViaAlb,
+		BaseUrl: server.URL + "/",
+		Checks:  []*SmokeCheck{{Name: "canary", Path: "/canary"}},
+	}
+	ctrl := gomock.NewController(t)
+	mocker, ctx := envars.Setup(ctrl, 2, "FARGATE")
+	result := envars.RollOut(ctx)
+	if result.Error != nil {
+		t.Fatalf(result.Error.Error())
+	}
+	// ルールとターゲットは片付ける
+	assert.Equal(t, 0, len(mocker.Rules))
+	assert.Equal(t, 0, len(mocker.Targets[envars.CanaryRoute.TargetGroupArn]))
+}
+
+func TestEnvars_OpenCanaryRoute(t *testing.T) {
+	envars := DefaultEnvars()
+	envars.CanaryRoute = &CanaryRouteConfig{
+		ListenerArn:    "arn://listener",
+		TargetGroupArn: "arn://aaa/hoge/targetgroup/canary/ccc",
+		HeaderName:     "X-Canary",
+		HeaderValue:    "1",
+	}
+	ctrl := gomock.NewController(t)
+	mocker, ctx := envars.Setup(ctrl, 1, "FARGATE")
+	_, _ = mocker.CreateRule(&elbv2.CreateRuleInput{
+		ListenerArn: aws.String("arn://listener"),
+		Priority:    aws.Int64(1),
+	})
+	route, err := envars.OpenCanaryRoute(ctx, aws.String("arn://aaa/hoge/targetgroup/aaa/bbb"), &elbv2.TargetDescription{
+		Id:   aws.String("127.0.0.1"),
+		Port: aws.Int64(80),
+	})
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	rule := mocker.Rules[*route.RuleArn]
+	// 使われている優先度は避ける
+	assert.Equal(t, "2", *rule.Priority)
+	assert.Equal(t, "X-Canary", *rule.Conditions[0].HttpHeaderConfig.HttpHeaderName)
+	assert.Equal(t, envars.CanaryRoute.TargetGroupArn, *rule.Actions[0].TargetGroupArn)
+	assert.Equal(t, 1, len(mocker.Targets[envars.CanaryRoute.TargetGroupArn]))
+	route.Close()
+	assert.Equal(t, 1, len(mocker.Rules))
+	assert.Equal(t, 0, len(mocker.Targets[envars.CanaryRoute.TargetGroupArn]))
+}
@@ -0,0 +1,174 @@
+package cage
+
+import (
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"time"
+)
+
+// EXTERNAL コントローラのサービスはカナリアサービスを作らずにタスクセットでロールアウトする
+// 小さなスケールでタスクセットを作成し、ターゲットのヘルスを確認してから100%にしてプライマリに昇格させる
+const kCanaryTaskSetScale = 1.0
+const kTaskSetPollInterval = time.Duration(15) * time.Second
+const kTaskSetMaxAttempts = 40
+
+func IsExternalService(service *ecs.Service) bool {
+	return service.DeploymentController != nil &&
+		aws.StringValue(service.DeploymentController.Type) == ecs.DeploymentControllerTypeExternal
+}
+
+func (envars *Envars) RollOutWithTaskSet(
+	ctx *Context,
+	service *ecs.Service,
+	nextTaskDefinition *ecs.TaskDefinition,
+	enter func(RollOutPhase) error,
+	ret *RollOutResult,
+) error {
+	logger := envars.logger(ctx)
+	var previous []*ecs.TaskSet
+	for _, v := range service.TaskSets {
+		if *v.Status != "DRAINING" {
+			previous = append(previous, v)
+		}
+	}
+	logger.WithField("scale", kCanaryTaskSetScale).Info("creating canary task set...")
+	input := &ecs.CreateTaskSetInput{
+		Cluster:                  envars.Cluster,
+		Service:                  envars.Service,
+		TaskDefinition:           nextTaskDefinition.TaskDefinitionArn,
+		CapacityProviderStrategy: service.CapacityProviderStrategy,
+		LaunchType:               service.LaunchType,
+		LoadBalancers:            service.LoadBalancers,
+		NetworkConfiguration:     service.NetworkConfiguration,
+		PlatformVersion:          service.PlatformVersion,
+		ServiceRegistries:        service.ServiceRegistries,
+		Scale: &ecs.Scale{
+			Unit:  aws.String(ecs.ScaleUnitPercent),
+			Value: aws.Float64(kCanaryTaskSetScale),
+		},
+	}
+	if len(service.CapacityProviderStrategy) > 0 {
+		input.LaunchType = nil
+	}
+	o, err := ctx.Ecs.CreateTaskSet(input)
+	if err != nil {
+		logger.WithError(err).Error("failed to create canary task set")
+		return err
+	}
+	taskSet := o.TaskSet
+	logger = logger.WithField("taskSet", *taskSet.Id)
+	logger.Info("task set created")
+	// 昇格前に失敗したら作ったタスクセットを消して元に戻す
+	abort := func(err error) error {
+		logger.Warn("deleting canary task set...")
+		if _, derr := ctx.Ecs.DeleteTaskSet(&ecs.DeleteTaskSetInput{
+			Cluster: envars.Cluster,
+			Service: envars.Service,
+			TaskSet: taskSet.Id,
+			Force:   aws.Bool(true),
+		}); derr != nil {
+			logger.WithError(derr).Error("failed to delete canary task set")
+			ret.ServiceIntact = false
+		}
+		return err
+	}
+	if err := envars.WaitUntilTaskSetStable(ctx, taskSet.Id); err != nil {
+		return abort(err)
+	}
+	healthWaitStart := ctx.clock().Now()
+	var loadBalancer *ecs.LoadBalancer
+	var canaryTarget *elbv2.TargetDescription
+	if len(service.LoadBalancers) > 0 {
+		loadBalancer = service.LoadBalancers[0]
+		logger.Info("ensuring canary task to become healthy...")
+		if canaryTarget, err = envars.ensureTaskHealthy(ctx, &ecs.ListTasksInput{
+			Cluster:   envars.Cluster,
+			StartedBy: taskSet.Id,
+		}, loadBalancer); err != nil {
+			return abort(err)
+		}
+		logger.Info("🤩 canary task is healthy!")
+	}
+	ret.CanaryHealthWait = ctx.clock().Now().Sub(healthWaitStart)
+	// 健康になった時点で通知してから、スモークテストと承認で確かめる
+	if err := enter(PhaseCanaryHealthy); err != nil {
+		return abort(err)
+	}
+	if err := envars.VerifyCanary(ctx, loadBalancer, canaryTarget, ret, enter); err != nil {
+		return abort(err)
+	}
+	if err := enter(PhasePrimaryUpdating); err != nil {
+		return abort(err)
+	}
+	logger.WithField("scale", 100).Info("scaling task set up...")
+	if _, err := ctx.Ecs.UpdateTaskSet(&ecs.UpdateTaskSetInput{
+		Cluster: envars.Cluster,
+		Service: envars.Service,
+		TaskSet: taskSet.Id,
+		Scale: &ecs.Scale{
+			Unit:  aws.String(ecs.ScaleUnitPercent),
+			Value: aws.Float64(100),
+		},
+	}); err != nil {
+		return abort(err)
+	}
+	if err := envars.WaitUntilTaskSetStable(ctx, taskSet.Id); err != nil {
+		return abort(err)
+	}
+	ret.ServiceIntact = false
+	logger.Info("promoting task set to primary...")
+	if _, err := ctx.Ecs.UpdateServicePrimaryTaskSet(&ecs.UpdateServicePrimaryTaskSetInput{
+		Cluster:        envars.Cluster,
+		Service:        envars.Service,
+		PrimaryTaskSet: taskSet.Id,
+	}); err != nil {
+		logger.WithError(err).Error("failed to promote task set")
+		return err
+	}
+	for _, v := range previous {
+		logger := logger.WithField("previousTaskSet", *v.Id)
+		logger.Info("deleting previous task set...")
+		if _, err := ctx.Ecs.DeleteTaskSet(&ecs.DeleteTaskSetInput{
+			Cluster: envars.Cluster,
+			Service: envars.Service,
+			TaskSet: v.Id,
+			Force:   aws.Bool(true),
+		}); err != nil {
+			logger.WithError(err).Error("failed to delete previous task set")
+			return err
+		}
+	}
+	logger.Info("🥴 task set is now primary of service!")
+	return nil
+}
+
+func (envars *Envars) WaitUntilTaskSetStable(ctx *Context, taskSetId *string) error {
+	logger := envars.logger(ctx).WithField("taskSet", *taskSetId)
+	logger.Info("waiting for task set to reach steady state...")
+	for i := 0; i < kTaskSetMaxAttempts; i++ {
+		<-ctx.clock().NewTimer(kTaskSetPollInterval).C
+		o, err := ctx.Ecs.DescribeTaskSets(&ecs.DescribeTaskSetsInput{
+			Cluster:  envars.Cluster,
+			Service:  envars.Service,
+			TaskSets: []*string{taskSetId},
+		})
+		if err != nil {
+			return err
+		}
+		if len(o.TaskSets) == 0 {
+			return NewErrorf("task set '%s' not found", *taskSetId)
+		}
+		ts := o.TaskSets[0]
+		logger.WithFields(log.Fields{
+			"running": aws.Int64Value(ts.RunningCount),
+			"desired": aws.Int64Value(ts.ComputedDesiredCount),
+			"status":  aws.StringValue(ts.StabilityStatus),
+		}).Info("task set state")
+		if aws.StringValue(ts.StabilityStatus) == ecs.StabilityStatusSteadyState {
+			return nil
+		}
+	}
+	return NewErrorf("task set '%s' hasn't reached steady state within maximum attempt windows", *taskSetId)
+}
@@ -0,0 +1,93 @@
+package cage
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/golang/mock/gomock"
+	"github.com/loilo-inc/canarycage/mock/mock_elbv2"
+	"github.com/loilo-inc/canarycage/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func setupExternal(t *testing.T, ctrl *gomock.Controller, envars *Envars, desiredCount int64) (*test.MockContext, *Context, *ecs.TaskSet) {
+	mocker, ctx := envars.Setup(ctrl, desiredCount, "FARGATE")
+	s, _ := mocker.GetService(*envars.Service)
+	s.DeploymentController = &ecs.DeploymentController{
+		Type: aws.String(ecs.DeploymentControllerTypeExternal),
+	}
+	o, _ := mocker.CreateTaskSet(&ecs.CreateTaskSetInput{
+		Cluster:        envars.Cluster,
+		Service:        envars.Service,
+		TaskDefinition: s.TaskDefinition,
+		LaunchType:     s.LaunchType,
+		LoadBalancers:  s.LoadBalancers,
+		Scale: &ecs.Scale{
+			Unit:  aws.String(ecs.ScaleUnitPercent),
+			Value: aws.Float64(100),
+		},
+	})
+	_, _ = mocker.UpdateServicePrimaryTaskSet(&ecs.UpdateServicePrimaryTaskSetInput{
+		Cluster:        envars.Cluster,
+		Service:        envars.Service,
+		PrimaryTaskSet: o.TaskSet.Id,
+	})
+	return mocker, ctx, o.TaskSet
+}
+
+func TestEnvars_RollOut_External(t *testing.T) {
+	for _, v := range []int64{1, 2, 15} {
+		envars := DefaultEnvars()
+		ctrl := gomock.NewController(t)
+		mocker, ctx, previous := setupExternal(t, ctrl, envars, v)
+		result := envars.RollOut(ctx)
+		if result.Error != nil {
+			t.Fatalf("%s", result.Error)
+		}
+		assert.False(t, result.ServiceIntact)
+		// カナリアサービスは作らない
+		assert.Equal(t, int64(1), mocker.ServiceSize())
+		assert.Equal(t, int64(1), mocker.TaskSetSize())
+		s, _ := mocker.GetService(*envars.Service)
+		primary := s.TaskSets[0]
+		assert.NotEqual(t, *previous.Id, *primary.Id)
+		assert.Equal(t, "PRIMARY", *primary.Status)
+		assert.Equal(t, v, *primary.RunningCount)
+	}
+}
+
+func TestEnvars_RollOut_ExternalUnhealthy(t *testing.T) {
+	// カナリアのタスクセットが健康にならなければ削除して元のまま
+	envars := DefaultEnvars()
+	ctrl := gomock.NewController(t)
+	mocker, ctx, previous := setupExternal(t, ctrl, envars, 2)
+	albMock := mock_elbv2.NewMockELBV2API(ctrl)
+	albMock.EXPECT().DescribeTargetGroups(gomock.Any()).DoAndReturn(mocker.DescribeTargetGroups).AnyTimes()
+	albMock.EXPECT().DescribeTargetHealth(gomock.Any()).DoAndReturn(func(input *elbv2.DescribeTargetHealthInput) (*elbv2.DescribeTargetHealthOutput, error) {
+		return &elbv2.DescribeTargetHealthOutput{
+			TargetHealthDescriptions: []*elbv2.TargetHealthDescription{{
+				Target:       input.Targets[0],
+				TargetHealth: &elbv2.TargetHealth{State: aws.String("unhealthy")},
+			}},
+		}, nil
+	}).AnyTimes()
+	ctx.Alb = albMock
+	result := envars.RollOut(ctx)
+	assert.NotNil(t, result.Error)
+	assert.True(t, result.ServiceIntact)
+	assert.Equal(t, int64(1), mocker.TaskSetSize())
+	s, _ := mocker.GetService(*envars.Service)
+	assert.Equal(t, *previous.Id, *s.TaskSets[0].Id)
+}
+
+func TestEnvars_Rollback_External(t *testing.T) {
+	envars := DefaultEnvars()
+	mocker, ctx, previous := setupExternal(t, gomock.NewController(t), envars, 1)
+	err := envars.Rollback(ctx, previous.TaskDefinition)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "EXTERNAL deployment controller")
+	}
+	// タスクセットはそのまま
+	assert.Equal(t, int64(1), mocker.TaskSetSize())
+}
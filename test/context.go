@@ -9,20 +9,44 @@ import (
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/google/uuid"
+	"math"
 	"regexp"
 	"sync"
 )
 
 type MockContext struct {
-	Services map[string]*ecs.Service
-	Tasks    map[string]*ecs.Task
-	mux      sync.Mutex
+	Services        map[string]*ecs.Service
+	Tasks           map[string]*ecs.Task
+	TaskSets        map[string]*ecs.TaskSet
+	TaskDefinitions map[string]*ecs.TaskDefinition
+	// DescribeTargetGroupsで返すターゲットタイプ
+	TargetType string
+	// リスナールール (1つのリスナーのもの) と、ターゲットグループに登録したターゲット
+	Rules   map[string]*elbv2.Rule
+	Targets map[string][]*elbv2.TargetDescription
+	// CreateTargetGroupで作ったターゲットグループ
+	TargetGroups map[string]*elbv2.TargetGroup
+	// RunTaskで起動したタスクのコンテナの終了コード
+	RunTaskExitCode int64
+	// trueならRunTaskで起動したタスクは止まらない
+	RunTaskKeepsRunning bool
+	hostPort            int64
+	mux                 sync.Mutex
 }
 
+const kEc2InstanceId = "i-1234567890abcdefg"
+
 func NewMockContext() *MockContext {
 	return &MockContext{
-		Services: make(map[string]*ecs.Service),
-		Tasks:    make(map[string]*ecs.Task),
+		Services:        make(map[string]*ecs.Service),
+		Tasks:           make(map[string]*ecs.Task),
+		TaskSets:        make(map[string]*ecs.TaskSet),
+		TaskDefinitions: make(map[string]*ecs.TaskDefinition),
+		TargetType:      "ip",
+		Rules:           make(map[string]*elbv2.Rule),
+		Targets:         make(map[string][]*elbv2.TargetDescription),
+		TargetGroups:    make(map[string]*elbv2.TargetGroup),
+		hostPort:        32768,
 	}
 }
 
@@ -80,7 +104,9 @@ func (ctx *MockContext) CreateService(input *ecs.CreateServiceInput) (*ecs.Creat
 		ServiceName:                   input.ServiceName,
 		RunningCount:                  aws.Int64(0),
 		LaunchType:                    input.LaunchType,
+		CapacityProviderStrategy:      input.CapacityProviderStrategy,
 		LoadBalancers:                 input.LoadBalancers,
+		ServiceRegistries:             input.ServiceRegistries,
 		DesiredCount:                  input.DesiredCount,
 		TaskDefinition:                input.TaskDefinition,
 		HealthCheckGracePeriodSeconds: aws.Int64(0),
@@ -176,12 +202,32 @@ func (ctx *MockContext) DeleteService(input *ecs.DeleteServiceInput) (*ecs.Delet
 
 func (ctx *MockContext) RegisterTaskDefinition(input *ecs.RegisterTaskDefinitionInput) (*ecs.RegisterTaskDefinitionOutput, error) {
 	idstr := uuid.New().String()
+	ret := &ecs.TaskDefinition{
+		TaskDefinitionArn: &idstr,
+		Family:            aws.String("family"),
+		Revision:          aws.Int64(1),
+	}
+	if input != nil {
+		ret.NetworkMode = input.NetworkMode
+		ret.ContainerDefinitions = input.ContainerDefinitions
+	}
+	ctx.mux.Lock()
+	defer ctx.mux.Unlock()
+	ctx.TaskDefinitions[idstr] = ret
 	return &ecs.RegisterTaskDefinitionOutput{
-		TaskDefinition: &ecs.TaskDefinition{
-			TaskDefinitionArn: &idstr,
-			Family:            aws.String("family"),
-			Revision:          aws.Int64(1),
-		},
+		TaskDefinition: ret,
+	}, nil
+}
+
+func (ctx *MockContext) DescribeTaskDefinition(input *ecs.DescribeTaskDefinitionInput) (*ecs.DescribeTaskDefinitionOutput, error) {
+	ctx.mux.Lock()
+	defer ctx.mux.Unlock()
+	td, ok := ctx.TaskDefinitions[*input.TaskDefinition]
+	if !ok {
+		return nil, errors.New(fmt.Sprintf("task definition:%s not found", *input.TaskDefinition))
+	}
+	return &ecs.DescribeTaskDefinitionOutput{
+		TaskDefinition: td,
 	}, nil
 }
 
@@ -201,6 +247,7 @@ func (ctx *MockContext) StartTask(input *ecs.StartTaskInput) (*ecs.StartTaskOutp
 		ClusterArn:        input.Cluster,
 		TaskDefinitionArn: input.TaskDefinition,
 		Group:             input.Group,
+		StartedBy:         input.StartedBy,
 	}
 	ctx.mux.Lock()
 	defer ctx.mux.Unlock()
@@ -208,16 +255,94 @@ func (ctx *MockContext) StartTask(input *ecs.StartTaskInput) (*ecs.StartTaskOutp
 	s := ctx.Services[m[1]]
 	*s.RunningCount += 1
 	ret.LaunchType = s.LaunchType
-	if *(s.LaunchType) == "FARGATE" {
+	launchType := aws.StringValue(s.LaunchType)
+	if len(s.CapacityProviderStrategy) > 0 {
+		// キャパシティプロバイダで起動したタスクはlaunchTypeを持たない
+		ret.LaunchType = nil
+		ret.CapacityProviderName = s.CapacityProviderStrategy[0].CapacityProvider
+		launchType = "EC2"
+		if p := *ret.CapacityProviderName; p == "FARGATE" || p == "FARGATE_SPOT" {
+			launchType = "FARGATE"
+		}
+	}
+	tdArn := input.TaskDefinition
+	if tdArn == nil {
+		tdArn = s.TaskDefinition
+	}
+	networkMode := "bridge"
+	if td, ok := ctx.TaskDefinitions[aws.StringValue(tdArn)]; ok && td.NetworkMode != nil {
+		networkMode = *td.NetworkMode
+	}
+	if launchType == "FARGATE" || networkMode == "awsvpc" {
 		ret.Attachments = attachments
-	} else {
+	}
+	if launchType != "FARGATE" {
 		ret.ContainerInstanceArn = aws.String("arn:aws:ecs:us-west-2:1234567890:container-instance/12345678-hoge-hoge-1234-1f2o3o4ba5r")
 	}
+	for _, lb := range s.LoadBalancers {
+		container := &ecs.Container{Name: lb.ContainerName}
+		switch networkMode {
+		case "bridge":
+			// bridgeはホストポートが動的に割り当てられる
+			container.NetworkBindings = []*ecs.NetworkBinding{{
+				ContainerPort: lb.ContainerPort,
+				HostPort:      aws.Int64(ctx.hostPort),
+			}}
+			ctx.hostPort++
+		case "host":
+			container.NetworkBindings = []*ecs.NetworkBinding{{
+				ContainerPort: lb.ContainerPort,
+				HostPort:      lb.ContainerPort,
+			}}
+		}
+		ret.Containers = append(ret.Containers, container)
+	}
 	return &ecs.StartTaskOutput{
 		Tasks: []*ecs.Task{ret},
 	}, nil
 }
 
+// RunTaskのタスクはすぐに終了してSTOPPEDで残る
+func (ctx *MockContext) RunTask(input *ecs.RunTaskInput) (*ecs.RunTaskOutput, error) {
+	ctx.mux.Lock()
+	defer ctx.mux.Unlock()
+	td, ok := ctx.TaskDefinitions[aws.StringValue(input.TaskDefinition)]
+	if !ok {
+		return nil, errors.New(fmt.Sprintf("task definition:%s not found", aws.StringValue(input.TaskDefinition)))
+	}
+	idstr := uuid.New().String()
+	ret := &ecs.Task{
+		TaskArn:           &idstr,
+		ClusterArn:        input.Cluster,
+		TaskDefinitionArn: input.TaskDefinition,
+		Group:             aws.String(fmt.Sprintf("family:%s", *td.Family)),
+		StartedBy:         input.StartedBy,
+		LaunchType:        input.LaunchType,
+		LastStatus:        aws.String("STOPPED"),
+		StoppedReason:     aws.String("Essential container in task exited"),
+		Overrides:         input.Overrides,
+	}
+	for _, c := range td.ContainerDefinitions {
+		ret.Containers = append(ret.Containers, &ecs.Container{
+			Name:       c.Name,
+			LastStatus: aws.String("STOPPED"),
+			ExitCode:   aws.Int64(ctx.RunTaskExitCode),
+		})
+	}
+	if ctx.RunTaskKeepsRunning {
+		ret.LastStatus = aws.String("RUNNING")
+		ret.StoppedReason = nil
+		for _, c := range ret.Containers {
+			c.LastStatus = aws.String("RUNNING")
+			c.ExitCode = nil
+		}
+	}
+	ctx.Tasks[idstr] = ret
+	return &ecs.RunTaskOutput{
+		Tasks: []*ecs.Task{ret},
+	}, nil
+}
+
 func (ctx *MockContext) StopTask(input *ecs.StopTaskInput) (*ecs.StopTaskOutput, error) {
 	ctx.mux.Lock()
 	defer ctx.mux.Unlock()
@@ -225,9 +350,11 @@ func (ctx *MockContext) StopTask(input *ecs.StopTaskInput) (*ecs.StopTaskOutput,
 	ret := ctx.Tasks[*input.Task]
 	delete(ctx.Tasks, *input.Task)
 	reg := regexp.MustCompile("service:(.+?)$")
-	m := reg.FindStringSubmatch(*ret.Group)
-	service := ctx.Services[m[1]]
-	*service.RunningCount -= 1
+	// RunTaskで起動したタスクはサービスに属さない
+	if m := reg.FindStringSubmatch(*ret.Group); m != nil {
+		service := ctx.Services[m[1]]
+		*service.RunningCount -= 1
+	}
 	return &ecs.StopTaskOutput{
 		Task: ret,
 	}, nil
@@ -238,10 +365,13 @@ func (ctx *MockContext) ListTasks(input *ecs.ListTasksInput) (*ecs.ListTasksOutp
 	ctx.mux.Lock()
 	defer ctx.mux.Unlock()
 	for _, v := range ctx.Tasks {
-		group := fmt.Sprintf("service:%s", *input.ServiceName)
-		if *v.Group == group {
-			ret = append(ret, v.TaskArn)
+		if input.ServiceName != nil && *v.Group != fmt.Sprintf("service:%s", *input.ServiceName) {
+			continue
 		}
+		if input.StartedBy != nil && (v.StartedBy == nil || *v.StartedBy != *input.StartedBy) {
+			continue
+		}
+		ret = append(ret, v.TaskArn)
 	}
 	return &ecs.ListTasksOutput{
 		TaskArns: ret,
@@ -265,7 +395,10 @@ func (ctx *MockContext) DescribeServices(input *ecs.DescribeServicesInput) (*ecs
 	defer ctx.mux.Unlock()
 	for _, v := range input.Services {
 		if s, ok := ctx.Services[*v]; ok {
-			ret = append(ret, s)
+			// タグはTagResourceで書き換えるのでコピーを返す
+			copied := *s
+			copied.Tags = copyTags(s.Tags)
+			ret = append(ret, &copied)
 		}
 	}
 	return &ecs.DescribeServicesOutput{
@@ -298,7 +431,7 @@ func (ctx *MockContext) WaitUntilTasksStopped(input *ecs.DescribeTasksInput) err
 	ctx.mux.Lock()
 	defer ctx.mux.Unlock()
 	for _, v := range input.Tasks {
-		if _, ok := ctx.Tasks[*v]; ok {
+		if t, ok := ctx.Tasks[*v]; ok && aws.StringValue(t.LastStatus) != "STOPPED" {
 			return errors.New(fmt.Sprintf("task:%s found", *v))
 		}
 	}
@@ -323,7 +456,7 @@ func (ctx *MockContext) DescribeContainerInstances(input *ecs.DescribeContainerI
 	ctx.mux.Lock()
 	defer ctx.mux.Unlock()
 	var ret []*ecs.ContainerInstance
-	ec2Id := "i-1234567890abcdefg"
+	ec2Id := kEc2InstanceId
 	instance := ecs.ContainerInstance{
 		Ec2InstanceId: &ec2Id,
 	}
@@ -333,6 +466,223 @@ func (ctx *MockContext) DescribeContainerInstances(input *ecs.DescribeContainerI
 	}, nil
 }
 
+func (ctx *MockContext) TaskSetSize() int64 {
+	ctx.mux.Lock()
+	defer ctx.mux.Unlock()
+	return int64(len(ctx.TaskSets))
+}
+
+func (ctx *MockContext) CreateTaskSet(input *ecs.CreateTaskSetInput) (*ecs.CreateTaskSetOutput, error) {
+	idstr := fmt.Sprintf("ecs-svc/%s", uuid.New().String())
+	ret := &ecs.TaskSet{
+		Id:                   &idstr,
+		TaskSetArn:           aws.String(fmt.Sprintf("arn:aws:ecs:us-west-2:1234567890:task-set/%s", idstr)),
+		Status:               aws.String("ACTIVE"),
+		TaskDefinition:       input.TaskDefinition,
+		LaunchType:           input.LaunchType,
+		LoadBalancers:        input.LoadBalancers,
+		NetworkConfiguration: input.NetworkConfiguration,
+		Scale:                input.Scale,
+		ComputedDesiredCount: aws.Int64(0),
+		RunningCount:         aws.Int64(0),
+		StabilityStatus:      aws.String("STEADY_STATE"),
+		StartedBy:            &idstr,
+	}
+	ctx.mux.Lock()
+	s, ok := ctx.Services[*input.Service]
+	if !ok {
+		ctx.mux.Unlock()
+		return nil, errors.New(fmt.Sprintf("service:%s not found", *input.Service))
+	}
+	ctx.TaskSets[idstr] = ret
+	s.TaskSets = append(s.TaskSets, ret)
+	ctx.mux.Unlock()
+	ctx.scaleTaskSet(input.Cluster, s, ret)
+	return &ecs.CreateTaskSetOutput{
+		TaskSet: ret,
+	}, nil
+}
+
+func (ctx *MockContext) UpdateTaskSet(input *ecs.UpdateTaskSetInput) (*ecs.UpdateTaskSetOutput, error) {
+	ctx.mux.Lock()
+	ts, ok := ctx.TaskSets[*input.TaskSet]
+	s := ctx.Services[*input.Service]
+	if ok {
+		ts.Scale = input.Scale
+	}
+	ctx.mux.Unlock()
+	if !ok {
+		return nil, errors.New(fmt.Sprintf("task set:%s not found", *input.TaskSet))
+	}
+	ctx.scaleTaskSet(input.Cluster, s, ts)
+	return &ecs.UpdateTaskSetOutput{
+		TaskSet: ts,
+	}, nil
+}
+
+func (ctx *MockContext) scaleTaskSet(cluster *string, s *ecs.Service, ts *ecs.TaskSet) {
+	desired := int64(math.Ceil(float64(*s.DesiredCount) * *ts.Scale.Value / 100))
+	var current []*string
+	ctx.mux.Lock()
+	for _, v := range ctx.Tasks {
+		if v.StartedBy != nil && *v.StartedBy == *ts.Id {
+			current = append(current, v.TaskArn)
+		}
+	}
+	ctx.mux.Unlock()
+	for i := int64(len(current)); i < desired; i++ {
+		ctx.StartTask(&ecs.StartTaskInput{
+			Cluster:        cluster,
+			Group:          aws.String(fmt.Sprintf("service:%s", *s.ServiceName)),
+			TaskDefinition: ts.TaskDefinition,
+			StartedBy:      ts.Id,
+		})
+	}
+	for i := desired; i < int64(len(current)); i++ {
+		ctx.StopTask(&ecs.StopTaskInput{
+			Cluster: cluster,
+			Task:    current[i],
+		})
+	}
+	ctx.mux.Lock()
+	ts.ComputedDesiredCount = aws.Int64(desired)
+	ts.RunningCount = aws.Int64(desired)
+	ctx.mux.Unlock()
+}
+
+func (ctx *MockContext) DescribeTaskSets(input *ecs.DescribeTaskSetsInput) (*ecs.DescribeTaskSetsOutput, error) {
+	ctx.mux.Lock()
+	defer ctx.mux.Unlock()
+	var ret []*ecs.TaskSet
+	for _, v := range input.TaskSets {
+		if ts, ok := ctx.TaskSets[*v]; ok {
+			ret = append(ret, ts)
+		}
+	}
+	return &ecs.DescribeTaskSetsOutput{
+		TaskSets: ret,
+	}, nil
+}
+
+func (ctx *MockContext) UpdateServicePrimaryTaskSet(input *ecs.UpdateServicePrimaryTaskSetInput) (*ecs.UpdateServicePrimaryTaskSetOutput, error) {
+	ctx.mux.Lock()
+	defer ctx.mux.Unlock()
+	primary, ok := ctx.TaskSets[*input.PrimaryTaskSet]
+	if !ok {
+		return nil, errors.New(fmt.Sprintf("task set:%s not found", *input.PrimaryTaskSet))
+	}
+	s := ctx.Services[*input.Service]
+	for _, v := range s.TaskSets {
+		if *v.Status == "PRIMARY" {
+			v.Status = aws.String("ACTIVE")
+		}
+	}
+	primary.Status = aws.String("PRIMARY")
+	s.TaskDefinition = primary.TaskDefinition
+	return &ecs.UpdateServicePrimaryTaskSetOutput{
+		TaskSet: primary,
+	}, nil
+}
+
+func (ctx *MockContext) DeleteTaskSet(input *ecs.DeleteTaskSetInput) (*ecs.DeleteTaskSetOutput, error) {
+	ctx.mux.Lock()
+	ts, ok := ctx.TaskSets[*input.TaskSet]
+	s := ctx.Services[*input.Service]
+	ctx.mux.Unlock()
+	if !ok {
+		return nil, errors.New(fmt.Sprintf("task set:%s not found", *input.TaskSet))
+	}
+	ts.Scale = &ecs.Scale{Unit: aws.String("PERCENT"), Value: aws.Float64(0)}
+	ctx.scaleTaskSet(input.Cluster, s, ts)
+	ctx.mux.Lock()
+	defer ctx.mux.Unlock()
+	delete(ctx.TaskSets, *input.TaskSet)
+	var taskSets []*ecs.TaskSet
+	for _, v := range s.TaskSets {
+		if *v.Id != *input.TaskSet {
+			taskSets = append(taskSets, v)
+		}
+	}
+	s.TaskSets = taskSets
+	return &ecs.DeleteTaskSetOutput{
+		TaskSet: ts,
+	}, nil
+}
+
+func (ctx *MockContext) findServiceByArn(arn string) *ecs.Service {
+	for _, s := range ctx.Services {
+		if *s.ServiceArn == arn {
+			return s
+		}
+	}
+	return nil
+}
+
+func (ctx *MockContext) TagResource(input *ecs.TagResourceInput) (*ecs.TagResourceOutput, error) {
+	ctx.mux.Lock()
+	defer ctx.mux.Unlock()
+	s := ctx.findServiceByArn(*input.ResourceArn)
+	if s == nil {
+		return nil, errors.New(fmt.Sprintf("resource:%s not found", *input.ResourceArn))
+	}
+	for _, tag := range input.Tags {
+		replaced := false
+		for _, v := range s.Tags {
+			if *v.Key == *tag.Key {
+				v.Value = tag.Value
+				replaced = true
+			}
+		}
+		if !replaced {
+			s.Tags = append(s.Tags, &ecs.Tag{Key: tag.Key, Value: tag.Value})
+		}
+	}
+	return &ecs.TagResourceOutput{}, nil
+}
+
+func (ctx *MockContext) UntagResource(input *ecs.UntagResourceInput) (*ecs.UntagResourceOutput, error) {
+	ctx.mux.Lock()
+	defer ctx.mux.Unlock()
+	s := ctx.findServiceByArn(*input.ResourceArn)
+	if s == nil {
+		return nil, errors.New(fmt.Sprintf("resource:%s not found", *input.ResourceArn))
+	}
+	var tags []*ecs.Tag
+	for _, v := range s.Tags {
+		removed := false
+		for _, key := range input.TagKeys {
+			if *v.Key == *key {
+				removed = true
+			}
+		}
+		if !removed {
+			tags = append(tags, v)
+		}
+	}
+	s.Tags = tags
+	return &ecs.UntagResourceOutput{}, nil
+}
+
+func (ctx *MockContext) ListTagsForResource(input *ecs.ListTagsForResourceInput) (*ecs.ListTagsForResourceOutput, error) {
+	ctx.mux.Lock()
+	defer ctx.mux.Unlock()
+	s := ctx.findServiceByArn(*input.ResourceArn)
+	if s == nil {
+		return nil, errors.New(fmt.Sprintf("resource:%s not found", *input.ResourceArn))
+	}
+	return &ecs.ListTagsForResourceOutput{
+		Tags: copyTags(s.Tags),
+	}, nil
+}
+
+func copyTags(tags []*ecs.Tag) []*ecs.Tag {
+	var ret []*ecs.Tag
+	for _, v := range tags {
+		ret = append(ret, &ecs.Tag{Key: v.Key, Value: v.Value})
+	}
+	return ret
+}
+
 //
 
 func (ctx *MockContext) DescribeTargetGroups(input *elbv2.DescribeTargetGroupsInput) (*elbv2.DescribeTargetGroupsOutput, error) {
@@ -344,6 +694,9 @@ func (ctx *MockContext) DescribeTargetGroups(input *elbv2.DescribeTargetGroupsIn
 				HealthyThresholdCount:      aws.Int64(1),
 				HealthCheckIntervalSeconds: aws.Int64(0),
 				LoadBalancerArns:           []*string{aws.String("arn://hoge/app/aa/bb")},
+				TargetType:                 aws.String(ctx.TargetType),
+				Protocol:                   aws.String("HTTP"),
+				HealthCheckEnabled:         aws.Bool(true),
 			},
 		},
 	}, nil
@@ -359,12 +712,37 @@ func (ctx *MockContext) DescribeTargetGroupAttibutes(input *elbv2.DescribeTarget
 	}, nil
 }
 func (ctx *MockContext) DescribeTargetHealth(input *elbv2.DescribeTargetHealthInput) (*elbv2.DescribeTargetHealthOutput, error) {
+	ctx.mux.Lock()
+	defer ctx.mux.Unlock()
+	// 実際にターゲットグループに登録されるターゲットを返す
+	var targets []*elbv2.TargetDescription
+	for _, task := range ctx.Tasks {
+		switch ctx.TargetType {
+		case "ip":
+			for _, a := range task.Attachments {
+				for _, d := range a.Details {
+					if *d.Name == "privateIPv4Address" {
+						targets = append(targets, &elbv2.TargetDescription{Id: d.Value, Port: input.Targets[0].Port})
+					}
+				}
+			}
+		case "instance":
+			if task.ContainerInstanceArn == nil {
+				continue
+			}
+			for _, c := range task.Containers {
+				for _, b := range c.NetworkBindings {
+					targets = append(targets, &elbv2.TargetDescription{Id: aws.String(kEc2InstanceId), Port: b.HostPort})
+				}
+			}
+		}
+	}
 	var ret []*elbv2.TargetHealthDescription
-	for i := int64(0); i < ctx.TaskSize(); i++ {
+	for _, v := range targets {
 		ret = append(ret, &elbv2.TargetHealthDescription{
 			Target: &elbv2.TargetDescription{
-				Id:               input.Targets[0].Id,
-				Port:             input.Targets[0].Port,
+				Id:               v.Id,
+				Port:             v.Port,
 				AvailabilityZone: aws.String("us-west-2"),
 			},
 			TargetHealth: &elbv2.TargetHealth{
@@ -376,3 +754,105 @@ func (ctx *MockContext) DescribeTargetHealth(input *elbv2.DescribeTargetHealthIn
 		TargetHealthDescriptions: ret,
 	}, nil
 }
+
+func (ctx *MockContext) RegisterTargets(input *elbv2.RegisterTargetsInput) (*elbv2.RegisterTargetsOutput, error) {
+	ctx.mux.Lock()
+	defer ctx.mux.Unlock()
+	ctx.Targets[*input.TargetGroupArn] = append(ctx.Targets[*input.TargetGroupArn], input.Targets...)
+	return &elbv2.RegisterTargetsOutput{}, nil
+}
+
+func (ctx *MockContext) DeregisterTargets(input *elbv2.DeregisterTargetsInput) (*elbv2.DeregisterTargetsOutput, error) {
+	ctx.mux.Lock()
+	defer ctx.mux.Unlock()
+	var rest []*elbv2.TargetDescription
+	for _, v := range ctx.Targets[*input.TargetGroupArn] {
+		found := false
+		for _, t := range input.Targets {
+			if *v.Id == *t.Id && aws.Int64Value(v.Port) == aws.Int64Value(t.Port) {
+				found = true
+			}
+		}
+		if !found {
+			rest = append(rest, v)
+		}
+	}
+	ctx.Targets[*input.TargetGroupArn] = rest
+	return &elbv2.DeregisterTargetsOutput{}, nil
+}
+
+func (ctx *MockContext) CreateTargetGroup(input *elbv2.CreateTargetGroupInput) (*elbv2.CreateTargetGroupOutput, error) {
+	ctx.mux.Lock()
+	defer ctx.mux.Unlock()
+	arn := fmt.Sprintf("arn://elbv2/targetgroup/%s/%s", *input.Name, uuid.New().String())
+	tg := &elbv2.TargetGroup{
+		TargetGroupArn:     &arn,
+		TargetGroupName:    input.Name,
+		Protocol:           input.Protocol,
+		Port:               input.Port,
+		VpcId:              input.VpcId,
+		TargetType:         input.TargetType,
+		HealthCheckEnabled: input.HealthCheckEnabled,
+		HealthCheckPath:    input.HealthCheckPath,
+	}
+	ctx.TargetGroups[arn] = tg
+	return &elbv2.CreateTargetGroupOutput{TargetGroups: []*elbv2.TargetGroup{tg}}, nil
+}
+
+func (ctx *MockContext) DeleteTargetGroup(input *elbv2.DeleteTargetGroupInput) (*elbv2.DeleteTargetGroupOutput, error) {
+	ctx.mux.Lock()
+	defer ctx.mux.Unlock()
+	if _, ok := ctx.TargetGroups[*input.TargetGroupArn]; !ok {
+		return nil, errors.New(fmt.Sprintf("target group:%s not found", *input.TargetGroupArn))
+	}
+	for _, r := range ctx.Rules {
+		for _, a := range r.Actions {
+			if aws.StringValue(a.TargetGroupArn) == *input.TargetGroupArn {
+				return nil, errors.New(fmt.Sprintf("target group:%s is in use by rule:%s", *input.TargetGroupArn, *r.RuleArn))
+			}
+		}
+	}
+	delete(ctx.TargetGroups, *input.TargetGroupArn)
+	delete(ctx.Targets, *input.TargetGroupArn)
+	return &elbv2.DeleteTargetGroupOutput{}, nil
+}
+
+func (ctx *MockContext) CreateRule(input *elbv2.CreateRuleInput) (*elbv2.CreateRuleOutput, error) {
+	ctx.mux.Lock()
+	defer ctx.mux.Unlock()
+	priority := fmt.Sprintf("%d", *input.Priority)
+	for _, v := range ctx.Rules {
+		if *v.Priority == priority {
+			return nil, errors.New(fmt.Sprintf("priority %s is in use", priority))
+		}
+	}
+	arn := fmt.Sprintf("%s/rule/%s", *input.ListenerArn, uuid.New().String())
+	rule := &elbv2.Rule{
+		RuleArn:    &arn,
+		Priority:   &priority,
+		Conditions: input.Conditions,
+		Actions:    input.Actions,
+	}
+	ctx.Rules[arn] = rule
+	return &elbv2.CreateRuleOutput{Rules: []*elbv2.Rule{rule}}, nil
+}
+
+func (ctx *MockContext) DeleteRule(input *elbv2.DeleteRuleInput) (*elbv2.DeleteRuleOutput, error) {
+	ctx.mux.Lock()
+	defer ctx.mux.Unlock()
+	if _, ok := ctx.Rules[*input.RuleArn]; !ok {
+		return nil, errors.New(fmt.Sprintf("rule:%s not found", *input.RuleArn))
+	}
+	delete(ctx.Rules, *input.RuleArn)
+	return &elbv2.DeleteRuleOutput{}, nil
+}
+
+func (ctx *MockContext) DescribeRules(input *elbv2.DescribeRulesInput) (*elbv2.DescribeRulesOutput, error) {
+	ctx.mux.Lock()
+	defer ctx.mux.Unlock()
+	ret := []*elbv2.Rule{{Priority: aws.String("default"), IsDefault: aws.Bool(true)}}
+	for _, v := range ctx.Rules {
+		ret = append(ret, v)
+	}
+	return &elbv2.DescribeRulesOutput{Rules: ret}, nil
+}
@@ -0,0 +1,165 @@
+package test
+
+import (
+	"errors"
+	"fmt"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DynamoDB のローカル代替
+// 単一のパーティションキーを持つテーブルと、ロックで使う程度の条件式だけを扱う
+type MockDynamo struct {
+	KeyName string
+	Tables  map[string]map[string]map[string]*dynamodb.AttributeValue
+	mux     sync.Mutex
+}
+
+func NewMockDynamo(keyName string) *MockDynamo {
+	return &MockDynamo{
+		KeyName: keyName,
+		Tables:  make(map[string]map[string]map[string]*dynamodb.AttributeValue),
+	}
+}
+
+func (ctx *MockDynamo) GetItem(input *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+	ctx.mux.Lock()
+	defer ctx.mux.Unlock()
+	return &dynamodb.GetItemOutput{
+		Item: ctx.table(*input.TableName)[*input.Key[ctx.KeyName].S],
+	}, nil
+}
+
+func (ctx *MockDynamo) PutItem(input *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error) {
+	ctx.mux.Lock()
+	defer ctx.mux.Unlock()
+	table := ctx.table(*input.TableName)
+	key := *input.Item[ctx.KeyName].S
+	if err := evaluateCondition(input.ConditionExpression, input.ExpressionAttributeNames, input.ExpressionAttributeValues, table[key]); err != nil {
+		return nil, err
+	}
+	table[key] = input.Item
+	return &dynamodb.PutItemOutput{}, nil
+}
+
+func (ctx *MockDynamo) UpdateItem(input *dynamodb.UpdateItemInput) (*dynamodb.UpdateItemOutput, error) {
+	ctx.mux.Lock()
+	defer ctx.mux.Unlock()
+	table := ctx.table(*input.TableName)
+	key := *input.Key[ctx.KeyName].S
+	item := table[key]
+	if err := evaluateCondition(input.ConditionExpression, input.ExpressionAttributeNames, input.ExpressionAttributeValues, item); err != nil {
+		return nil, err
+	}
+	if item == nil {
+		item = map[string]*dynamodb.AttributeValue{
+			ctx.KeyName: input.Key[ctx.KeyName],
+		}
+		table[key] = item
+	}
+	// SET a = :a, b = :b のみ
+	for _, assign := range strings.Split(strings.TrimPrefix(*input.UpdateExpression, "SET "), ",") {
+		kv := strings.SplitN(assign, "=", 2)
+		if len(kv) != 2 {
+			return nil, errors.New(fmt.Sprintf("unsupported update expression: %s", *input.UpdateExpression))
+		}
+		item[resolveName(strings.TrimSpace(kv[0]), input.ExpressionAttributeNames)] = input.ExpressionAttributeValues[strings.TrimSpace(kv[1])]
+	}
+	return &dynamodb.UpdateItemOutput{}, nil
+}
+
+func (ctx *MockDynamo) DeleteItem(input *dynamodb.DeleteItemInput) (*dynamodb.DeleteItemOutput, error) {
+	ctx.mux.Lock()
+	defer ctx.mux.Unlock()
+	table := ctx.table(*input.TableName)
+	key := *input.Key[ctx.KeyName].S
+	if err := evaluateCondition(input.ConditionExpression, input.ExpressionAttributeNames, input.ExpressionAttributeValues, table[key]); err != nil {
+		return nil, err
+	}
+	delete(table, key)
+	return &dynamodb.DeleteItemOutput{}, nil
+}
+
+func (ctx *MockDynamo) table(name string) map[string]map[string]*dynamodb.AttributeValue {
+	if _, ok := ctx.Tables[name]; !ok {
+		ctx.Tables[name] = make(map[string]map[string]*dynamodb.AttributeValue)
+	}
+	return ctx.Tables[name]
+}
+
+var notExistsReg = regexp.MustCompile(`^attribute_not_exists\((.+?)\)$`)
+var compareReg = regexp.MustCompile(`^(\S+)\s*(=|<|>)\s*(\S+)$`)
+
+// "a OR b", "a AND b", "attribute_not_exists(x)", "x = :v", "x < :v", "x > :v" を評価する
+func evaluateCondition(
+	cond *string,
+	names map[string]*string,
+	values map[string]*dynamodb.AttributeValue,
+	item map[string]*dynamodb.AttributeValue,
+) error {
+	if cond == nil || *cond == "" {
+		return nil
+	}
+	for _, or := range strings.Split(*cond, " OR ") {
+		ok := true
+		for _, and := range strings.Split(or, " AND ") {
+			term := strings.TrimSpace(and)
+			if m := notExistsReg.FindStringSubmatch(term); m != nil {
+				if _, exists := item[resolveName(m[1], names)]; exists {
+					ok = false
+				}
+			} else if m := compareReg.FindStringSubmatch(term); m != nil {
+				attr, exists := item[resolveName(m[1], names)]
+				if !exists || !compareValues(attr, m[2], values[m[3]]) {
+					ok = false
+				}
+			} else {
+				return errors.New(fmt.Sprintf("unsupported condition expression: %s", *cond))
+			}
+		}
+		if ok {
+			return nil
+		}
+	}
+	return awserr.New(dynamodb.ErrCodeConditionalCheckFailedException, "The conditional request failed", nil)
+}
+
+func resolveName(name string, names map[string]*string) string {
+	if v, ok := names[name]; ok {
+		return *v
+	}
+	return name
+}
+
+func compareValues(a *dynamodb.AttributeValue, op string, b *dynamodb.AttributeValue) bool {
+	if a == nil || b == nil {
+		return false
+	}
+	if a.N != nil && b.N != nil {
+		x, _ := strconv.ParseFloat(*a.N, 64)
+		y, _ := strconv.ParseFloat(*b.N, 64)
+		switch op {
+		case "=":
+			return x == y
+		case "<":
+			return x < y
+		case ">":
+			return x > y
+		}
+	}
+	if a.S != nil && b.S != nil {
+		switch op {
+		case "=":
+			return *a.S == *b.S
+		case "<":
+			return *a.S < *b.S
+		case ">":
+			return *a.S > *b.S
+		}
+	}
+	return false
+}
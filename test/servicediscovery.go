@@ -0,0 +1,93 @@
+package test
+
+import (
+	"errors"
+	"fmt"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"strings"
+)
+
+// Cloud Map のローカル代替
+// MockContextのサービスのServiceRegistriesを見て、タスクをインスタンスとして返す
+type MockServiceDiscovery struct {
+	Ecs *MockContext
+	// GetInstancesHealthStatusで返すステータス
+	HealthStatus string
+	// falseならヘルスチェックなしのサービスとして振る舞う
+	HealthCheck bool
+}
+
+func NewMockServiceDiscovery(ecs *MockContext) *MockServiceDiscovery {
+	return &MockServiceDiscovery{
+		Ecs:          ecs,
+		HealthStatus: servicediscovery.HealthStatusHealthy,
+		HealthCheck:  true,
+	}
+}
+
+func (ctx *MockServiceDiscovery) GetService(input *servicediscovery.GetServiceInput) (*servicediscovery.GetServiceOutput, error) {
+	ret := &servicediscovery.Service{
+		Id:  input.Id,
+		Arn: aws.String(fmt.Sprintf("arn:aws:servicediscovery:us-west-2:1234567890:service/%s", *input.Id)),
+	}
+	if ctx.HealthCheck {
+		ret.HealthCheckCustomConfig = &servicediscovery.HealthCheckCustomConfig{
+			FailureThreshold: aws.Int64(1),
+		}
+	}
+	return &servicediscovery.GetServiceOutput{
+		Service: ret,
+	}, nil
+}
+
+func (ctx *MockServiceDiscovery) instanceIds(serviceId string) []string {
+	ctx.Ecs.mux.Lock()
+	defer ctx.Ecs.mux.Unlock()
+	var ret []string
+	for _, s := range ctx.Ecs.Services {
+		registered := false
+		for _, r := range s.ServiceRegistries {
+			if r.RegistryArn != nil && strings.HasSuffix(*r.RegistryArn, "/"+serviceId) {
+				registered = true
+			}
+		}
+		if !registered {
+			continue
+		}
+		for _, t := range ctx.Ecs.Tasks {
+			if *t.Group == fmt.Sprintf("service:%s", *s.ServiceName) {
+				ret = append(ret, (*t.TaskArn)[strings.LastIndex(*t.TaskArn, "/")+1:])
+			}
+		}
+	}
+	return ret
+}
+
+func (ctx *MockServiceDiscovery) ListInstances(input *servicediscovery.ListInstancesInput) (*servicediscovery.ListInstancesOutput, error) {
+	var ret []*servicediscovery.InstanceSummary
+	for _, id := range ctx.instanceIds(*input.ServiceId) {
+		ret = append(ret, &servicediscovery.InstanceSummary{
+			Id: aws.String(id),
+			Attributes: map[string]*string{
+				"AWS_INSTANCE_IPV4": aws.String("127.0.0.1"),
+			},
+		})
+	}
+	return &servicediscovery.ListInstancesOutput{
+		Instances: ret,
+	}, nil
+}
+
+func (ctx *MockServiceDiscovery) GetInstancesHealthStatus(input *servicediscovery.GetInstancesHealthStatusInput) (*servicediscovery.GetInstancesHealthStatusOutput, error) {
+	if !ctx.HealthCheck {
+		return nil, errors.New("service has no health check")
+	}
+	ret := make(map[string]*string)
+	for _, id := range ctx.instanceIds(*input.ServiceId) {
+		ret[id] = aws.String(ctx.HealthStatus)
+	}
+	return &servicediscovery.GetInstancesHealthStatusOutput{
+		Status: ret,
+	}, nil
+}
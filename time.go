@@ -2,19 +2,27 @@ package cage
 
 import "time"
 
-var newTimer = time.NewTimer
-var now = time.Now
+// 時刻とタイマーを差し替える。cagetestのシミュレーターで時間を進めるのに使う
+type Clock interface {
+	Now() time.Time
+	NewTimer(d time.Duration) *time.Timer
+}
 
-func fakeTimer(d time.Duration) *time.Timer {
-	ch := make(chan time.Time)
-	go func() {
-		ch <- time.Now()
-	}()
-	return &time.Timer{
-		C: ch,
-	}
+// 実際の時刻とタイマー
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
 }
 
-func recoverTimer() {
-	newTimer = time.NewTimer
-}
\ No newline at end of file
+func (systemClock) NewTimer(d time.Duration) *time.Timer {
+	return time.NewTimer(d)
+}
+
+// Context.Clock が指定されていなければ実際の時計
+func (ctx *Context) clock() Clock {
+	if ctx != nil && ctx.Clock != nil {
+		return ctx.Clock
+	}
+	return systemClock{}
+}
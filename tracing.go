@@ -0,0 +1,439 @@
+package cage
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ロールアウトの各フェーズとAWSのAPI呼び出しをOpenTelemetryのトレースとして記録する
+// Go 1.10でビルドできるようにSDKは使わず、OTLP/HTTPのJSONエンコーディングで直接送る
+// 対応するのはOTLP/HTTP JSONだけで、OTLP/gRPCやprotobufエンコーディングしか受けないコレクタには送れない
+const kTracerScope = "github.com/loilo-inc/canarycage"
+const kDefaultTraceServiceName = "canarycage"
+
+// OTEL_* はOpenTelemetryの標準の環境変数
+const OtlpEndpointKey = "OTEL_EXPORTER_OTLP_ENDPOINT"
+const OtlpHeadersKey = "OTEL_EXPORTER_OTLP_HEADERS"
+const OtelServiceNameKey = "OTEL_SERVICE_NAME"
+const TraceFileKey = "CAGE_TRACE_FILE"
+
+const (
+	SpanKindInternal = 1
+	SpanKindClient   = 3
+)
+
+const (
+	SpanStatusUnset = 0
+	SpanStatusOk    = 1
+	SpanStatusError = 2
+)
+
+type Span struct {
+	TraceId       string
+	SpanId        string
+	ParentSpanId  string
+	Name          string
+	Kind          int
+	StartTime     time.Time
+	EndTime       time.Time
+	Attributes    map[string]interface{}
+	StatusCode    int
+	StatusMessage string
+	tracer        *Tracer
+}
+
+type SpanExporter interface {
+	Export(serviceName string, spans []*Span) error
+}
+
+// nilのTracerは何も記録しない
+// 現在のスパンはTracerごとに持つので、並行するロールアウトはScopeで分けたTracerを使う
+type Tracer struct {
+	ServiceName string
+	Exporters   []SpanExporter
+	// スパンの時刻を測る。指定しなければ実際の時計
+	Clock   Clock
+	traceId string
+	spans   []*Span
+	stack   []*Span
+	mux     sync.Mutex
+	// Scopeで作ったTracerはスパンをparentに記録する
+	parent *Tracer
+	// Scopeで作ったときのparentの現在のスパン
+	base *Span
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func NewTracer(exporters ...SpanExporter) *Tracer {
+	name := os.Getenv(OtelServiceNameKey)
+	if name == "" {
+		name = kDefaultTraceServiceName
+	}
+	return &Tracer{
+		ServiceName: name,
+		Exporters:   exporters,
+		traceId:     randomHex(16),
+	}
+}
+
+// スパンを同じトレースに記録し、現在のスパンだけを別に持つTracerを返す
+// 今の現在のスパンが新しいTracerのスパンの親になる
+func (t *Tracer) Scope() *Tracer {
+	if t == nil {
+		return nil
+	}
+	root := t.root()
+	root.mux.Lock()
+	defer root.mux.Unlock()
+	return &Tracer{
+		ServiceName: t.ServiceName,
+		Exporters:   t.Exporters,
+		Clock:       t.Clock,
+		traceId:     root.traceId,
+		parent:      root,
+		base:        t.current(),
+	}
+}
+
+func (t *Tracer) root() *Tracer {
+	if t.parent != nil {
+		return t.parent
+	}
+	return t
+}
+
+func (t *Tracer) now() time.Time {
+	if t.Clock == nil {
+		return time.Now()
+	}
+	return t.Clock.Now()
+}
+
+func (t *Tracer) newSpan(name string, kind int, parent *Span) *Span {
+	span := &Span{
+		TraceId:    t.traceId,
+		SpanId:     randomHex(8),
+		Name:       name,
+		Kind:       kind,
+		StartTime:  t.now(),
+		Attributes: make(map[string]interface{}),
+		tracer:     t,
+	}
+	if parent != nil {
+		span.ParentSpanId = parent.SpanId
+	}
+	root := t.root()
+	root.spans = append(root.spans, span)
+	return span
+}
+
+func (t *Tracer) current() *Span {
+	if len(t.stack) == 0 {
+		return t.base
+	}
+	return t.stack[len(t.stack)-1]
+}
+
+// 現在のスパンの子としてスパンを開始し、終了するまで以降のスパンの親にする
+func (t *Tracer) Start(name string) *Span {
+	if t == nil {
+		return nil
+	}
+	t.root().mux.Lock()
+	defer t.root().mux.Unlock()
+	span := t.newSpan(name, SpanKindInternal, t.current())
+	t.stack = append(t.stack, span)
+	return span
+}
+
+// 現在のスパンの子としてクライアントのスパンを開始する。以降のスパンの親にはならない
+func (t *Tracer) StartClient(name string) *Span {
+	if t == nil {
+		return nil
+	}
+	t.root().mux.Lock()
+	defer t.root().mux.Unlock()
+	return t.newSpan(name, SpanKindClient, t.current())
+}
+
+func (s *Span) SetAttribute(key string, value interface{}) {
+	if s == nil {
+		return
+	}
+	s.tracer.root().mux.Lock()
+	defer s.tracer.root().mux.Unlock()
+	s.Attributes[key] = value
+}
+
+func (s *Span) SetError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.tracer.root().mux.Lock()
+	defer s.tracer.root().mux.Unlock()
+	s.StatusCode = SpanStatusError
+	s.StatusMessage = err.Error()
+}
+
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	t := s.tracer
+	t.root().mux.Lock()
+	defer t.root().mux.Unlock()
+	if !s.EndTime.IsZero() {
+		return
+	}
+	s.EndTime = t.now()
+	for i := len(t.stack) - 1; i >= 0; i-- {
+		if t.stack[i] == s {
+			t.stack = append(t.stack[:i], t.stack[i+1:]...)
+			break
+		}
+	}
+}
+
+func (t *Tracer) Spans() []*Span {
+	if t == nil {
+		return nil
+	}
+	t = t.root()
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	return append([]*Span{}, t.spans...)
+}
+
+// 終了していないスパンを閉じて記録したスパンをエクスポートする
+func (t *Tracer) Shutdown() error {
+	if t == nil {
+		return nil
+	}
+	t = t.root()
+	t.mux.Lock()
+	for _, v := range t.spans {
+		if v.EndTime.IsZero() {
+			v.EndTime = t.now()
+		}
+	}
+	spans := t.spans
+	t.spans = nil
+	t.stack = nil
+	t.mux.Unlock()
+	if len(spans) == 0 {
+		return nil
+	}
+	var errs []string
+	for _, e := range t.Exporters {
+		if err := e.Export(t.ServiceName, spans); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return NewErrorf("failed to export spans: %s", strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// AWS SDKのリクエストごとにクライアントスパンを記録する
+func InstrumentSession(ses *session.Session, tracer *Tracer) {
+	if tracer == nil {
+		return
+	}
+	ses.Handlers.Build.PushFrontNamed(request.NamedHandler{
+		Name: "cage.tracing.Start",
+		Fn: func(r *request.Request) {
+			span := tracer.StartClient(fmt.Sprintf("%s.%s", r.ClientInfo.ServiceName, r.Operation.Name))
+			span.SetAttribute("rpc.system", "aws-api")
+			span.SetAttribute("rpc.service", r.ClientInfo.ServiceName)
+			span.SetAttribute("rpc.method", r.Operation.Name)
+			r.SetContext(context.WithValue(r.Context(), spanContextKey{}, span))
+		},
+	})
+	ses.Handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "cage.tracing.End",
+		Fn: func(r *request.Request) {
+			span, ok := r.Context().Value(spanContextKey{}).(*Span)
+			if !ok {
+				return
+			}
+			if r.RequestID != "" {
+				span.SetAttribute("aws.request_id", r.RequestID)
+			}
+			if r.HTTPResponse != nil {
+				span.SetAttribute("http.status_code", r.HTTPResponse.StatusCode)
+			}
+			if r.RetryCount > 0 {
+				span.SetAttribute("aws.retry_count", r.RetryCount)
+			}
+			span.SetError(r.Error)
+			span.End()
+		},
+	})
+}
+
+type spanContextKey struct{}
+
+// OTLP/JSONの形式
+type otlpTraces struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceId           string         `json:"traceId"`
+	SpanId            string         `json:"spanId"`
+	ParentSpanId      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+}
+
+func newOtlpValue(v interface{}) otlpValue {
+	switch o := v.(type) {
+	case string:
+		return otlpValue{StringValue: &o}
+	case int:
+		s := strconv.Itoa(o)
+		return otlpValue{IntValue: &s}
+	case int64:
+		s := strconv.FormatInt(o, 10)
+		return otlpValue{IntValue: &s}
+	case float64:
+		return otlpValue{DoubleValue: &o}
+	case bool:
+		return otlpValue{BoolValue: &o}
+	}
+	s := fmt.Sprintf("%v", v)
+	return otlpValue{StringValue: &s}
+}
+
+func NewOtlpTraces(serviceName string, spans []*Span) *otlpTraces {
+	var ret []otlpSpan
+	for _, s := range spans {
+		var attrs []otlpKeyValue
+		for k, v := range s.Attributes {
+			attrs = append(attrs, otlpKeyValue{Key: k, Value: newOtlpValue(v)})
+		}
+		ret = append(ret, otlpSpan{
+			TraceId:           s.TraceId,
+			SpanId:            s.SpanId,
+			ParentSpanId:      s.ParentSpanId,
+			Name:              s.Name,
+			Kind:              s.Kind,
+			StartTimeUnixNano: strconv.FormatInt(s.StartTime.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(s.EndTime.UnixNano(), 10),
+			Attributes:        attrs,
+			Status:            otlpStatus{Code: s.StatusCode, Message: s.StatusMessage},
+		})
+	}
+	return &otlpTraces{
+		ResourceSpans: []otlpResourceSpans{{
+			Resource: otlpResource{
+				Attributes: []otlpKeyValue{{Key: "service.name", Value: newOtlpValue(serviceName)}},
+			},
+			ScopeSpans: []otlpScopeSpans{{
+				Scope: otlpScope{Name: kTracerScope},
+				Spans: ret,
+			}},
+		}},
+	}
+}
+
+// "key1=value1,key2=value2"
+func ParseOtlpHeaders(str string) map[string]string {
+	ret := make(map[string]string)
+	for _, kv := range strings.Split(str, ",") {
+		if i := strings.Index(kv, "="); i > 0 {
+			ret[strings.TrimSpace(kv[:i])] = strings.TrimSpace(kv[i+1:])
+		}
+	}
+	return ret
+}
+
+// OTLP/HTTPのコレクターに送る
+type OtlpHttpExporter struct {
+	Endpoint string
+	Headers  map[string]string
+	Client   *http.Client
+}
+
+func NewOtlpHttpExporter(endpoint string, headers map[string]string) *OtlpHttpExporter {
+	return &OtlpHttpExporter{
+		Endpoint: endpoint,
+		Headers:  headers,
+		Client:   &http.Client{Timeout: kNotifyHttpTimeout},
+	}
+}
+
+func (e *OtlpHttpExporter) Export(serviceName string, spans []*Span) error {
+	url := strings.TrimSuffix(e.Endpoint, "/") + "/v1/traces"
+	return postJson(e.Client, url, e.Headers, NewOtlpTraces(serviceName, spans))
+}
+
+// OTLP/JSONをファイルに書く
+type JsonFileExporter struct {
+	Path string
+}
+
+func (e *JsonFileExporter) Export(serviceName string, spans []*Span) error {
+	d, err := json.MarshalIndent(NewOtlpTraces(serviceName, spans), "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(e.Path, d, 0644)
+}
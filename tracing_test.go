@@ -0,0 +1,231 @@
+package cage
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func spanByName(spans []*Span, name string) *Span {
+	for _, v := range spans {
+		if v.Name == name {
+			return v
+		}
+	}
+	return nil
+}
+
+func TestTracer_Start(t *testing.T) {
+	tracer := NewTracer()
+	root := tracer.Start("root")
+	child := tracer.Start("child")
+	client := tracer.StartClient("ecs.DescribeServices")
+	client.End()
+	child.SetError(errors.New("err"))
+	child.End()
+	sibling := tracer.Start("sibling")
+	sibling.End()
+	root.End()
+	spans := tracer.Spans()
+	assert.Equal(t, 4, len(spans))
+	assert.Equal(t, "", root.ParentSpanId)
+	assert.Equal(t, root.SpanId, child.ParentSpanId)
+	assert.Equal(t, child.SpanId, client.ParentSpanId)
+	assert.Equal(t, SpanKindClient, client.Kind)
+	// 終了したスパンは親にならない
+	assert.Equal(t, root.SpanId, sibling.ParentSpanId)
+	assert.Equal(t, SpanStatusError, child.StatusCode)
+	assert.Equal(t, "err", child.StatusMessage)
+	for _, v := range spans {
+		assert.Equal(t, root.TraceId, v.TraceId)
+	}
+}
+
+func TestTracer_Scope(t *testing.T) {
+	// 並行するロールアウトのスパンは互いの子にならない
+	tracer := NewTracer()
+	root := tracer.Start("manifest")
+	api, worker := tracer.Scope(), tracer.Scope()
+	apiSpan := api.Start("rollout")
+	workerSpan := worker.Start("rollout")
+	apiClient := api.StartClient("ecs.DescribeServices")
+	workerClient := worker.StartClient("ecs.DescribeServices")
+	apiSpan.End()
+	workerSpan.End()
+	root.End()
+	assert.Equal(t, root.SpanId, apiSpan.ParentSpanId)
+	assert.Equal(t, root.SpanId, workerSpan.ParentSpanId)
+	assert.Equal(t, apiSpan.SpanId, apiClient.ParentSpanId)
+	assert.Equal(t, workerSpan.SpanId, workerClient.ParentSpanId)
+	// スパンは元のTracerにまとめて記録する
+	assert.Equal(t, 5, len(tracer.Spans()))
+	assert.Equal(t, 5, len(api.Spans()))
+	assert.Equal(t, root.TraceId, workerClient.TraceId)
+	var nilTracer *Tracer
+	assert.Nil(t, nilTracer.Scope())
+}
+
+func TestTracer_Nil(t *testing.T) {
+	var tracer *Tracer
+	span := tracer.Start("root")
+	span.SetAttribute("key", "value")
+	span.SetError(errors.New("err"))
+	span.End()
+	assert.Nil(t, span)
+	assert.Nil(t, tracer.Shutdown())
+}
+
+func TestEnvars_RollOut_Tracing(t *testing.T) {
+	envars := DefaultEnvars()
+	_, ctx := envars.Setup(gomock.NewController(t), 2, "FARGATE")
+	ctx.Tracer = NewTracer()
+	result := envars.RollOut(ctx)
+	assert.Nil(t, result.Error)
+	spans := ctx.Tracer.Spans()
+	root := spanByName(spans, "rollout")
+	if assert.NotNil(t, root) {
+		assert.Equal(t, "cage-test", root.Attributes["ecs.cluster"])
+		assert.Equal(t, "service", root.Attributes["ecs.service"])
+		assert.Equal(t, SpanStatusUnset, root.StatusCode)
+	}
+	for _, v := range []RollOutPhase{PhaseStarted, PhaseCanaryHealthy, PhasePrimaryUpdating} {
+		span := spanByName(spans, string(v))
+		if assert.NotNil(t, span, string(v)) {
+			assert.Equal(t, root.SpanId, span.ParentSpanId)
+			assert.False(t, span.EndTime.IsZero())
+		}
+	}
+	health := spanByName(spans, "canary_health_check")
+	if assert.NotNil(t, health) {
+		assert.Equal(t, spanByName(spans, string(PhaseStarted)).SpanId, health.ParentSpanId)
+	}
+}
+
+func TestEnvars_RollOut_TracingFailed(t *testing.T) {
+	envars := DefaultEnvars()
+	_, ctx := envars.Setup(gomock.NewController(t), 2, "FARGATE")
+	envars.TaskDefinitionArn = aws.String("arn://unknown")
+	ctx.Tracer = NewTracer()
+	result := envars.RollOut(ctx)
+	assert.NotNil(t, result.Error)
+	spans := ctx.Tracer.Spans()
+	assert.Equal(t, SpanStatusError, spanByName(spans, "rollout").StatusCode)
+	assert.Equal(t, SpanStatusError, spanByName(spans, string(PhaseStarted)).StatusCode)
+}
+
+func TestInstrumentSession(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Amzn-RequestId", "request-id")
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+	ses, err := session.NewSession(&aws.Config{
+		Region:      aws.String("us-west-2"),
+		Endpoint:    aws.String(server.URL),
+		Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+	})
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	tracer := NewTracer()
+	InstrumentSession(ses, tracer)
+	root := tracer.Start("root")
+	_, err = ecs.New(ses).ListClusters(&ecs.ListClustersInput{})
+	assert.Nil(t, err)
+	root.End()
+	span := spanByName(tracer.Spans(), "ecs.ListClusters")
+	if assert.NotNil(t, span) {
+		assert.Equal(t, root.SpanId, span.ParentSpanId)
+		assert.Equal(t, SpanKindClient, span.Kind)
+		assert.Equal(t, "ListClusters", span.Attributes["rpc.method"])
+		assert.Equal(t, "request-id", span.Attributes["aws.request_id"])
+		assert.Equal(t, 200, span.Attributes["http.status_code"])
+		assert.False(t, span.EndTime.IsZero())
+	}
+}
+
+func TestOtlpHttpExporter(t *testing.T) {
+	var path, auth string
+	var body otlpTraces
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		auth = r.Header.Get("Authorization")
+		json.NewDecoder(r.Body).Decode(&body)
+	}))
+	defer server.Close()
+	tracer := NewTracer(NewOtlpHttpExporter(server.URL+"/", map[string]string{"Authorization": "Bearer token"}))
+	tracer.ServiceName = "deploy"
+	span := tracer.Start("rollout")
+	span.SetAttribute("ecs.service", "service")
+	span.SetAttribute("aws.retry_count", 2)
+	// 終了していないスパンも送る
+	assert.Nil(t, tracer.Shutdown())
+	assert.Equal(t, "/v1/traces", path)
+	assert.Equal(t, "Bearer token", auth)
+	if assert.Equal(t, 1, len(body.ResourceSpans)) {
+		rs := body.ResourceSpans[0]
+		assert.Equal(t, "deploy", *rs.Resource.Attributes[0].Value.StringValue)
+		s := rs.ScopeSpans[0].Spans[0]
+		assert.Equal(t, "rollout", s.Name)
+		assert.Equal(t, 32, len(s.TraceId))
+		assert.Equal(t, 16, len(s.SpanId))
+		assert.NotEqual(t, "0", s.EndTimeUnixNano)
+		assert.Equal(t, 2, len(s.Attributes))
+	}
+	// 送った後は記録が空になる
+	assert.Equal(t, 0, len(tracer.Spans()))
+}
+
+func TestOtlpHttpExporter_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+	tracer := NewTracer(NewOtlpHttpExporter(server.URL, nil))
+	tracer.Start("rollout").End()
+	assert.NotNil(t, tracer.Shutdown())
+}
+
+func TestJsonFileExporter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cage-trace")
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "trace.json")
+	tracer := NewTracer(&JsonFileExporter{Path: path})
+	root := tracer.Start("rollout")
+	tracer.Start("started").End()
+	root.End()
+	assert.Nil(t, tracer.Shutdown())
+	d, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	var traces otlpTraces
+	assert.Nil(t, json.Unmarshal(d, &traces))
+	spans := traces.ResourceSpans[0].ScopeSpans[0].Spans
+	if assert.Equal(t, 2, len(spans)) {
+		assert.Equal(t, spans[0].SpanId, spans[1].ParentSpanId)
+		assert.Equal(t, kTracerScope, traces.ResourceSpans[0].ScopeSpans[0].Scope.Name)
+	}
+}
+
+func TestParseOtlpHeaders(t *testing.T) {
+	assert.Equal(t, map[string]string{
+		"Authorization": "Bearer token",
+		"x-tenant":      "a=b",
+	}, ParseOtlpHeaders("Authorization=Bearer token, x-tenant=a=b"))
+	assert.Equal(t, map[string]string{}, ParseOtlpHeaders(""))
+}